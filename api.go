@@ -7,7 +7,9 @@ type FromStep[TSource, TDest any] interface {
 }
 
 type ConvertStep[TSource, TDest any] interface {
-	ConvertWith(TypeConverter) ValidateStep[TSource, TDest]
+	PreValidateWith(Validator) ConvertStep[TSource, TDest]
+	ConvertWith(converters ...TypeConverter) ValidateStep[TSource, TDest]
+	ConvertFunc(convert func(TSource) (TDest, error)) ValidateStep[TSource, TDest]
 	SkipConversion() ValidateStep[TSource, TDest]
 }
 
@@ -17,6 +19,14 @@ type ValidateStep[TSource, TDest any] interface {
 }
 
 type BuildStep[TSource, TDest any] interface {
+	Named(name string) BuildStep[TSource, TDest]
+	Gating() BuildStep[TSource, TDest]
+	WithMetadata(metadata map[string]string) BuildStep[TSource, TDest]
+	WrapWith(wrap ValueWrapper) BuildStep[TSource, TDest]
+	ReadWith(read SourceAccessor) BuildStep[TSource, TDest]
+	WithDefault(value TDest) BuildStep[TSource, TDest]
+	Optional() BuildStep[TSource, TDest]
+	When(predicate func(TSource) bool) BuildStep[TSource, TDest]
 	Build() FieldMapping[TSource, TDest]
 }
 
@@ -31,10 +41,21 @@ type Validator interface {
 // FieldMappingBuilder provides a fluent API to construct a FieldMapping.
 // It allows specifying a source field, destination field, optional type converters, and validators.
 type FieldMappingBuilder[TSource, TDest any] struct {
-	from       FieldDef[TSource]
-	to         FieldDef[TDest]
-	validate   Validator
-	modifyType TypeConverter
+	from        FieldDef[TSource]
+	to          FieldDef[TDest]
+	name        string
+	preValidate Validator
+	validate    Validator
+	converters  []TypeConverter
+	gating      bool
+	optional    bool
+	metadata    map[string]string
+	wrap        ValueWrapper
+	read        SourceAccessor
+	when        func(TSource) bool
+
+	defaultValue TDest
+	hasDefault   bool
 }
 
 // From begins the construction of a FieldMappingBuilder with a source field.
@@ -72,17 +93,41 @@ func (b *FieldMappingBuilder[TSource, TDest]) ValidateWith(validator Validator)
 	return b
 }
 
-// ConvertWith attaches a TypeConverter to the FieldMappingBuilder.
-// This function transforms the input value before validation is performed.
+// PreValidateWith attaches a Validator that runs on the raw source value before conversion,
+// so malformed input can be rejected with a domain-friendly ValidationError instead of the
+// converter's own error. The validator must accept and return TSource.
+//
+// Example:
+//
+//	builder := builder.PreValidateWith(gomorph.DigitsOnly()).ConvertWith(StringToIntConverter{})
+func (b *FieldMappingBuilder[TSource, TDest]) PreValidateWith(validator Validator) ConvertStep[TSource, TDest] {
+	b.preValidate = validator
+	return b
+}
+
+// ConvertWith attaches one or more TypeConverters to the FieldMappingBuilder.
+// They transform the input value, in the order given, before validation is performed; the output
+// type of each converter must match the input type of the next.
 //
 // Example:
 //
 //	builder := builder.ConvertWith(StringToIntConverter{})
-func (b *FieldMappingBuilder[TSource, TDest]) ConvertWith(modifier TypeConverter) ValidateStep[TSource, TDest] {
-	b.modifyType = modifier
+//	builder := builder.ConvertWith(TrimConverter{}, UppercaseConverter{}, NormalizeConverter{})
+func (b *FieldMappingBuilder[TSource, TDest]) ConvertWith(converters ...TypeConverter) ValidateStep[TSource, TDest] {
+	b.converters = append(b.converters, converters...)
 	return b
 }
 
+// ConvertFunc attaches convert as the converter of the FieldMappingBuilder, wrapped in a
+// MapperFunc, for conversions too simple to deserve a TypeConverter type of their own.
+//
+// Example:
+//
+//	builder := builder.ConvertFunc(func(s string) (int, error) { return len(s), nil })
+func (b *FieldMappingBuilder[TSource, TDest]) ConvertFunc(convert func(TSource) (TDest, error)) ValidateStep[TSource, TDest] {
+	return b.ConvertWith(MapperFunc[TSource, TDest](convert))
+}
+
 func (b *FieldMappingBuilder[TSource, TDest]) SkipConversion() ValidateStep[TSource, TDest] {
 	return b
 }
@@ -91,6 +136,131 @@ func (b *FieldMappingBuilder[TSource, TDest]) SkipValidation() BuildStep[TSource
 	return b
 }
 
+// Named labels the mapping for diagnostics. The name is included in StructMapper error messages
+// and Describe output, which helps tell apart mappings that target similar fields or combine
+// several sources. Unnamed mappings are known by their target field name.
+//
+// Example:
+//
+//	builder := builder.Named("billing-postcode")
+func (b *FieldMappingBuilder[TSource, TDest]) Named(name string) BuildStep[TSource, TDest] {
+	b.name = name
+	return b
+}
+
+// Gating marks the mapping as critical for the struct it belongs to. A StructMapper maps gating
+// fields before any other field and aborts as soon as one of them fails, returning only that
+// error. Use it for fields such as a required ID where mapping the rest of the struct is
+// pointless once the gate has failed.
+//
+// Example:
+//
+//	mapping := gomorph.From[string, int]("ID").To("ID").
+//	    ConvertWith(StringToIntConverter{}).
+//	    SkipValidation().
+//	    Gating().
+//	    Build()
+func (b *FieldMappingBuilder[TSource, TDest]) Gating() BuildStep[TSource, TDest] {
+	b.gating = true
+	return b
+}
+
+// WithMetadata attaches arbitrary labels to the mapping, such as a description, a PII flag or
+// the originating system. Metadata does not affect mapping; it is exposed through
+// FieldMapping.Metadata and StructMapper.Describe for documentation and auditing. Calling it
+// more than once merges the maps, with later values winning.
+//
+// Example:
+//
+//	builder := builder.WithMetadata(map[string]string{"pii": "true"})
+func (b *FieldMappingBuilder[TSource, TDest]) WithMetadata(metadata map[string]string) BuildStep[TSource, TDest] {
+	if b.metadata == nil {
+		b.metadata = make(map[string]string, len(metadata))
+	}
+	for k, v := range metadata {
+		b.metadata[k] = v
+	}
+	return b
+}
+
+// WrapWith replaces NewTypedValue as the function used to wrap mapped values, letting advanced
+// pipelines attach metadata such as provenance to every value the mapping produces.
+//
+// Example:
+//
+//	builder := builder.WrapWith(func(v any, declared reflect.Type) gomorph.TypedValue {
+//	    return gomorph.NewTypedValue(v).WithMeta("source", "crm")
+//	})
+func (b *FieldMappingBuilder[TSource, TDest]) WrapWith(wrap ValueWrapper) BuildStep[TSource, TDest] {
+	b.wrap = wrap
+	return b
+}
+
+// ReadWith replaces the lookup of the source field by name with read, for inputs that cannot be
+// addressed by a single field name. The From field name is still used in error messages.
+//
+// Example:
+//
+//	mapping := gomorph.From[any, string]("zip").To("PostalCode").
+//	    ConvertWith(gomorph.AssertType[string]()).
+//	    SkipValidation().
+//	    ReadWith(gomorph.CoalesceKeys("zip", "postal_code")).
+//	    Build()
+func (b *FieldMappingBuilder[TSource, TDest]) ReadWith(read SourceAccessor) BuildStep[TSource, TDest] {
+	b.read = read
+	return b
+}
+
+// WithDefault assigns value to the target field when the source field is missing, such as a key
+// absent from a Record, instead of failing the struct mapping. The default is assigned as-is,
+// without running the converter or validators. It only applies when the field cannot be found;
+// conversion and validation errors on a present value are still reported.
+//
+// Example:
+//
+//	mapping := gomorph.From[string, string]("locale").To("Locale").
+//	    SkipConversion().
+//	    SkipValidation().
+//	    WithDefault("en-US").
+//	    Build()
+func (b *FieldMappingBuilder[TSource, TDest]) WithDefault(value TDest) BuildStep[TSource, TDest] {
+	b.defaultValue = value
+	b.hasDefault = true
+	return b
+}
+
+// Optional makes a missing source field leave the target field untouched: the field is skipped
+// and mapping continues, where it would otherwise fail. Unlike WithDefault nothing is written, so
+// the target keeps whatever value it already has. When both are set the default wins.
+//
+// Example:
+//
+//	mapping := gomorph.From[string, string]("nickname").To("Nickname").
+//	    SkipConversion().
+//	    SkipValidation().
+//	    Optional().
+//	    Build()
+func (b *FieldMappingBuilder[TSource, TDest]) Optional() BuildStep[TSource, TDest] {
+	b.optional = true
+	return b
+}
+
+// When makes the mapping conditional on the source value: the converter and validators only run
+// when predicate returns true. Otherwise the value is assigned unchanged if TSource fits TDest,
+// such as a string mapped to a string, and the field is skipped if it does not.
+//
+// Example:
+//
+//	mapping := gomorph.From[string, string]("phone").To("Phone").
+//	    ConvertWith(gomorph.NormalizeDigits()).
+//	    SkipValidation().
+//	    When(func(phone string) bool { return strings.HasPrefix(phone, "+1") }).
+//	    Build()
+func (b *FieldMappingBuilder[TSource, TDest]) When(predicate func(TSource) bool) BuildStep[TSource, TDest] {
+	b.when = predicate
+	return b
+}
+
 // Build finalizes the builder into a FieldMapping.
 // It constructs the underlying ChainedMapper using any attached converter and validator.
 // The resulting FieldMapping can then be used to transform and assign field values.
@@ -100,16 +270,30 @@ func (b *FieldMappingBuilder[TSource, TDest]) SkipValidation() BuildStep[TSource
 //	mapping := builder.Build()
 func (b *FieldMappingBuilder[TSource, TDest]) Build() FieldMapping[TSource, TDest] {
 	var mappers []TypedMapper
-	if b.modifyType != nil {
-		mappers = append(mappers, b.modifyType)
+	if b.preValidate != nil {
+		mappers = append(mappers, b.preValidate)
+	}
+	for _, converter := range b.converters {
+		mappers = append(mappers, converter)
 	}
 	if b.validate != nil {
 		mappers = append(mappers, b.validate)
 	}
 
-	return NewFieldMapping(
+	mapping := NewFieldMapping(
 		b.from,
 		b.to,
 		NewChainedMapper[TSource, TDest](mappers...),
 	)
+	mapping.name = b.name
+	mapping.gating = b.gating
+	mapping.optional = b.optional
+	mapping.metadata = b.metadata
+	mapping.wrap = b.wrap
+	mapping.read = b.read
+	mapping.when = b.when
+	mapping.defaultValue = b.defaultValue
+	mapping.hasDefault = b.hasDefault
+	mapping.validateOnly = len(b.converters) == 0 && len(mappers) > 0
+	return mapping
 }
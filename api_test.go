@@ -3,10 +3,12 @@ package gomorph_test
 import (
 	"errors"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/dklassen/gomorph"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func equalTypedValue(a, b gomorph.TypedValue) bool {
@@ -116,9 +118,259 @@ func TestFieldMappingBuilder_ValidatorFails(t *testing.T) {
 		ValidateWith(validator).
 		Build()
 
-	expectedErr := "mapper chain failed at step 1: validation failed"
+	expectedErr := "mapper chain failed at step 1 (gomorph_test.failingValidator): validation failed"
 	_, err := mapping.Map(42)
 	if err == nil || err.Error() != expectedErr {
 		t.Fatalf("expected '%s' error, got %v", expectedErr, err)
 	}
 }
+
+func TestFieldMappingBuilder_PreValidateWith(t *testing.T) {
+	mapping := gomorph.From[string, int]("src").
+		To("dst").
+		PreValidateWith(gomorph.DigitsOnly()).
+		ConvertWith(StringToIntMapper{}).
+		SkipValidation().
+		Build()
+
+	result, err := mapping.Map("123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !equalTypedValue(result.MappedValue(), gomorph.NewTypedValue(3)) {
+		t.Fatalf("expected 3, got %v", result.MappedValue())
+	}
+
+	_, err = mapping.Map("12a")
+	var validationErr *gomorph.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Code != gomorph.CodeNotNumeric {
+		t.Fatalf("expected a not_numeric validation error before conversion, got %v", err)
+	}
+	assert.EqualError(t, err, `mapper chain failed at step 1 (DigitsOnly): validation failed: "12a" must contain digits only`)
+}
+
+func TestFieldMappingBuilder_Named(t *testing.T) {
+	named := gomorph.From[string, string]("InputString").
+		To("MappedInputString").
+		ConvertWith(AlwaysFailingMapper{}).
+		SkipValidation().
+		Named("display-name").
+		Build()
+	unnamed := gomorph.From[int, int]("InputInt").
+		To("MappedInputInt").
+		SkipConversion().
+		SkipValidation().
+		Build()
+
+	assert.Equal(t, "display-name", named.Name())
+	assert.Equal(t, "MappedInputInt", unnamed.Name(), "unnamed mappings default to the target field")
+
+	mapper := gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{unnamed, named})
+	_, err := mapper.From(Input{InputString: "a"})
+	assert.EqualError(t, err, "mapping error [InputString (display-name)]: mapper chain failed at step 1 (gomorph_test.AlwaysFailingMapper): always fails error value")
+
+	descriptions := mapper.Describe()
+	require.Len(t, descriptions, 2)
+	assert.Equal(t, "MappedInputInt", descriptions[0].Name)
+	assert.Equal(t, "display-name", descriptions[1].Name)
+}
+
+type Preferences struct {
+	Locale  string
+	Retries int
+}
+
+func TestFieldMappingBuilder_WithDefault(t *testing.T) {
+	locale := gomorph.From[string, string]("locale").
+		To("Locale").
+		SkipConversion().
+		SkipValidation().
+		WithDefault("en-US").
+		Build()
+	retries := gomorph.From[string, int]("retries").
+		To("Retries").
+		ConvertWith(AtoiMapper{}).
+		SkipValidation().
+		WithDefault(3).
+		Build()
+	mapper := gomorph.NewStructMapper[gomorph.Record, Preferences]([]gomorph.FieldMapper{locale, retries})
+
+	tests := []struct {
+		name    string
+		source  gomorph.Record
+		want    Preferences
+		wantErr string
+	}{
+		{name: "present keys", source: gomorph.Record{"locale": "de-DE", "retries": "5"}, want: Preferences{Locale: "de-DE", Retries: 5}},
+		{name: "absent keys", source: gomorph.Record{}, want: Preferences{Locale: "en-US", Retries: 3}},
+		{name: "nil record", source: nil, want: Preferences{Locale: "en-US", Retries: 3}},
+		{
+			name:    "conversion errors are not defaulted",
+			source:  gomorph.Record{"retries": "many"},
+			wantErr: `mapping error [retries]: mapper chain failed at step 1 (gomorph_test.AtoiMapper): strconv.Atoi: parsing "many": invalid syntax`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mapper.From(tt.source)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+
+	_, report, err := mapper.FromVerbose(gomorph.Record{"locale": "fr-FR"})
+	require.NoError(t, err)
+	require.Len(t, report, 2)
+	assert.Equal(t, gomorph.FieldCopied, report[0].Status, "unused default")
+	assert.Equal(t, gomorph.FieldDefaulted, report[1].Status)
+	assert.Equal(t, "defaulted", report[1].Status.String())
+}
+
+func TestFieldMappingBuilder_Optional(t *testing.T) {
+	locale := gomorph.From[string, string]("locale").
+		To("Locale").
+		SkipConversion().
+		SkipValidation().
+		Optional().
+		Build()
+	retries := gomorph.From[string, int]("retries").
+		To("Retries").
+		ConvertWith(AtoiMapper{}).
+		SkipValidation().
+		Build()
+	mapper := gomorph.NewStructMapper[gomorph.Record, Preferences]([]gomorph.FieldMapper{locale, retries})
+
+	assert.True(t, locale.IsOptional())
+	assert.False(t, retries.IsOptional())
+
+	result, report, err := mapper.FromVerbose(gomorph.Record{"retries": "2"})
+	require.NoError(t, err)
+	assert.Equal(t, Preferences{Retries: 2}, result, "absent optional field keeps its zero value")
+	require.Len(t, report, 2)
+	assert.Equal(t, gomorph.FieldSkipped, report[0].Status)
+
+	result, err = mapper.From(gomorph.Record{"locale": "de-DE", "retries": "2"})
+	require.NoError(t, err)
+	assert.Equal(t, Preferences{Locale: "de-DE", Retries: 2}, result)
+
+	_, err = mapper.From(gomorph.Record{"locale": "de-DE"})
+	assert.ErrorIs(t, err, gomorph.ErrFieldNotFound, "required fields still fail")
+
+	withDefault := gomorph.From[string, string]("locale").
+		To("Locale").
+		SkipConversion().
+		SkipValidation().
+		Optional().
+		WithDefault("en-US").
+		Build()
+	mapper = gomorph.NewStructMapper[gomorph.Record, Preferences]([]gomorph.FieldMapper{withDefault})
+	result, err = mapper.From(gomorph.Record{})
+	require.NoError(t, err)
+	assert.Equal(t, "en-US", result.Locale, "a default takes precedence")
+}
+
+func TestFieldMappingBuilder_ConvertWithSeveral(t *testing.T) {
+	mapping := gomorph.From[string, string]("src").
+		To("dst").
+		ConvertWith(
+			gomorph.RequirePrefix("tel:"),
+			gomorph.NormalizeNewlines(" "),
+			gomorph.NormalizeDigits(),
+		).
+		SkipValidation().
+		Build()
+
+	result, err := mapping.Map("tel:+1 (555)\r\n010")
+	require.NoError(t, err)
+	assert.Equal(t, "1555010", result.MappedValue().Value())
+	assert.Equal(t, 3, mapping.Using().Len())
+
+	_, err = mapping.Map("12")
+	assert.ErrorContains(t, err, "mapper chain failed at step 1", "converters run in the order given")
+}
+
+func TestFieldMappingBuilder_ConvertWithMismatch(t *testing.T) {
+	assert.Panics(t, func() {
+		gomorph.From[string, string]("src").
+			To("dst").
+			ConvertWith(gomorph.NormalizeDigits(), intToStringConverter{}).
+			SkipValidation().
+			Build()
+	})
+}
+
+func TestFieldMappingBuilder_ConvertFunc(t *testing.T) {
+	mapping := gomorph.From[string, int]("InputString").
+		To("MappedInputInt").
+		ConvertFunc(func(s string) (int, error) {
+			if s == "" {
+				return 0, errors.New("empty input")
+			}
+			return len(s), nil
+		}).
+		SkipValidation().
+		Build()
+	mapper := gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{mapping})
+
+	result, err := mapper.From(Input{InputString: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.MappedInputInt)
+
+	_, err = mapper.From(Input{})
+	assert.EqualError(t, err, "mapping error [InputString]: mapper chain failed at step 1 (gomorph.MapperFunc[string,int]): empty input")
+}
+
+func TestFieldMappingBuilder_When(t *testing.T) {
+	usOnly := func(phone string) bool { return strings.HasPrefix(phone, "+1") }
+
+	t.Run("passes the value through when the predicate is false", func(t *testing.T) {
+		mapping := gomorph.From[string, string]("InputString").
+			To("MappedInputString").
+			ConvertWith(gomorph.NormalizeDigits()).
+			SkipValidation().
+			When(usOnly).
+			Build()
+		mapper := gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{mapping})
+
+		tests := []struct {
+			phone      string
+			want       string
+			wantStatus gomorph.FieldStatus
+		}{
+			{phone: "+1 (555) 010-9999", want: "15550109999", wantStatus: gomorph.FieldConverted},
+			{phone: "+44 20 7946 0000", want: "+44 20 7946 0000", wantStatus: gomorph.FieldCopied},
+		}
+		for _, tt := range tests {
+			result, report, err := mapper.FromVerbose(Input{InputString: tt.phone})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result.MappedInputString)
+			require.Len(t, report, 1)
+			assert.Equal(t, tt.wantStatus, report[0].Status)
+		}
+	})
+
+	t.Run("skips the field when the value cannot pass through", func(t *testing.T) {
+		mapping := gomorph.From[string, int]("InputString").
+			To("MappedInputInt").
+			ConvertWith(AtoiMapper{}).
+			SkipValidation().
+			When(func(s string) bool { return s != "" }).
+			Build()
+		mapper := gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{mapping})
+
+		result, err := mapper.From(Input{InputString: "12"})
+		require.NoError(t, err)
+		assert.Equal(t, 12, result.MappedInputInt)
+
+		result, report, err := mapper.FromVerbose(Input{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.MappedInputInt)
+		require.Len(t, report, 1)
+		assert.Equal(t, gomorph.FieldSkipped, report[0].Status)
+	})
+}
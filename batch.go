@@ -0,0 +1,77 @@
+package gomorph
+
+import (
+	"runtime"
+	"sync"
+)
+
+// MapBatch maps every input in order, returning one output per input. Inputs that fail leave the
+// zero value at their index and are reported together as IndexErrors; the other outputs are
+// still returned.
+func (b *StructMapper[TSource, TDest]) MapBatch(inputs []TSource) ([]TDest, error) {
+	outputs := make([]TDest, len(inputs))
+	errs := make(IndexErrors)
+	for i, input := range inputs {
+		output, err := b.From(input)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		outputs[i] = output
+	}
+	return outputs, batchError(errs)
+}
+
+// MapBatchParallel is MapBatch spread over a pool of workers goroutines; a workers value of zero
+// or less uses runtime.GOMAXPROCS(0). Outputs keep the order of inputs and failures are reported
+// by index exactly as with MapBatch.
+//
+// The field mappers, converters and validators of the StructMapper are called concurrently; see
+// ChainedMapper for the concurrency contract they have to meet.
+func (b *StructMapper[TSource, TDest]) MapBatchParallel(inputs []TSource, workers int) ([]TDest, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(inputs))
+
+	outputs := make([]TDest, len(inputs))
+	failures := make([]error, len(inputs))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				output, err := b.From(inputs[i])
+				if err != nil {
+					failures[i] = err
+					continue
+				}
+				outputs[i] = output
+			}
+		}()
+	}
+	for i := range inputs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	errs := make(IndexErrors)
+	for i, err := range failures {
+		if err != nil {
+			errs[i] = err
+		}
+	}
+	return outputs, batchError(errs)
+}
+
+// batchError returns errs, or nil when nothing failed, so callers do not get a non-nil empty map.
+func batchError(errs IndexErrors) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
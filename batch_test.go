@@ -0,0 +1,74 @@
+package gomorph_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func batchMapper() gomorph.StructMapper[Input, Output] {
+	return gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{
+		gomorph.From[string, int]("InputString").
+			To("MappedInputInt").
+			PreValidateWith(gomorph.MaxBytes(3)).
+			ConvertWith(StringToIntMapper{}).
+			SkipValidation().
+			Build(),
+	})
+}
+
+func batchInputs(n int) []Input {
+	inputs := make([]Input, n)
+	for i := range inputs {
+		inputs[i] = Input{InputString: fmt.Sprint(i % 1000)}
+	}
+	return inputs
+}
+
+func TestStructMapper_MapBatch(t *testing.T) {
+	mapper := batchMapper()
+	inputs := []Input{{InputString: "a"}, {InputString: "toolong"}, {InputString: "abc"}, {InputString: "12345"}}
+
+	tests := []struct {
+		name string
+		run  func([]Input) ([]Output, error)
+	}{
+		{name: "sequential", run: mapper.MapBatch},
+		{name: "parallel", run: func(in []Input) ([]Output, error) { return mapper.MapBatchParallel(in, 2) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputs, err := tt.run(inputs)
+			assert.Equal(t, []Output{{MappedInputInt: 1}, {}, {MappedInputInt: 3}, {}}, outputs)
+
+			var indexErrs gomorph.IndexErrors
+			require.True(t, errors.As(err, &indexErrs))
+			assert.Len(t, indexErrs, 2)
+			assert.ErrorContains(t, indexErrs[1], "mapping error [InputString]")
+			assert.ErrorContains(t, indexErrs[3], "mapping error [InputString]")
+			assert.ErrorContains(t, err, "2 item(s) failed: 1: mapping error [InputString]")
+		})
+	}
+}
+
+func TestStructMapper_MapBatchParallel_PreservesOrder(t *testing.T) {
+	mapper := batchMapper()
+	inputs := batchInputs(500)
+
+	sequential, err := mapper.MapBatch(inputs)
+	require.NoError(t, err)
+	for _, workers := range []int{0, 1, 7, 1000} {
+		parallel, err := mapper.MapBatchParallel(inputs, workers)
+		require.NoError(t, err)
+		assert.Equal(t, sequential, parallel, "workers=%d", workers)
+	}
+
+	empty, err := mapper.MapBatchParallel(nil, 4)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
@@ -0,0 +1,118 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	defaultTrueTokens    = []string{"true", "t", "yes", "y", "on", "1"}
+	defaultFalseTokens   = []string{"false", "f", "no", "n", "off", "0"}
+	defaultUnknownTokens = []string{"", "unknown", "n/a"}
+)
+
+// FlexibleBool parses the many ways sources spell booleans (any -> bool). Strings are trimmed and
+// matched case-insensitively against trueSet and falseSet; integers of any size are matched by
+// their decimal form, so 1 and "1" behave the same; bool values pass through unchanged. Anything
+// else, or a token in neither set, is an error. A nil set falls back to the defaults
+// (true/t/yes/y/on/1 and false/f/no/n/off/0).
+//
+// Example:
+//
+//	gomorph.FlexibleBool([]string{"Y"}, []string{"N"}) // accepts "y", "N", ...
+func FlexibleBool(trueSet, falseSet []string) TypedMapper {
+	if trueSet == nil {
+		trueSet = defaultTrueTokens
+	}
+	if falseSet == nil {
+		falseSet = defaultFalseTokens
+	}
+	trueTokens, falseTokens := tokenSet(trueSet), tokenSet(falseSet)
+
+	return namedFunc[any, bool]("FlexibleBool", func(value any) (bool, error) {
+		var token string
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			token = v
+		default:
+			rv := reflect.ValueOf(value)
+			switch {
+			case rv.CanInt():
+				token = strconv.FormatInt(rv.Int(), 10)
+			case rv.CanUint():
+				token = strconv.FormatUint(rv.Uint(), 10)
+			default:
+				return false, fmt.Errorf("cannot interpret %T as a boolean", value)
+			}
+		}
+
+		normalized := normalizeToken(token)
+		switch {
+		case trueTokens[normalized]:
+			return true, nil
+		case falseTokens[normalized]:
+			return false, nil
+		}
+		return false, fmt.Errorf("invalid boolean value %q", token)
+	})
+}
+
+// ParseTristate parses yes/no/unknown answers into an optional boolean (string -> *bool), for
+// survey and form fields where a missing answer is meaningful. Tokens are trimmed and matched
+// case-insensitively: a token in trueSet or falseSet yields a pointer to true or false, a token
+// in unknownSet yields nil, and anything else is an error. Nil sets fall back to the FlexibleBool
+// defaults, and to "", "unknown" and "n/a" for unknownSet.
+func ParseTristate(trueSet, falseSet, unknownSet []string) TypedMapper {
+	if trueSet == nil {
+		trueSet = defaultTrueTokens
+	}
+	if falseSet == nil {
+		falseSet = defaultFalseTokens
+	}
+	if unknownSet == nil {
+		unknownSet = defaultUnknownTokens
+	}
+	trueTokens, falseTokens, unknownTokens := tokenSet(trueSet), tokenSet(falseSet), tokenSet(unknownSet)
+
+	return namedFunc[string, *bool]("ParseTristate", func(value string) (*bool, error) {
+		normalized := normalizeToken(value)
+		switch {
+		case trueTokens[normalized]:
+			b := true
+			return &b, nil
+		case falseTokens[normalized]:
+			b := false
+			return &b, nil
+		case unknownTokens[normalized]:
+			return nil, nil
+		}
+		return nil, fmt.Errorf("invalid tristate value %q", value)
+	})
+}
+
+func tokenSet(tokens []string) map[string]bool {
+	set := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		set[normalizeToken(token)] = true
+	}
+	return set
+}
+
+func normalizeToken(token string) string {
+	return strings.ToLower(strings.TrimSpace(token))
+}
+
+// FormatBool renders a boolean in the convention of an output system (bool -> string), such as
+// "Y"/"N" or "1"/"0". It is the counterpart of FlexibleBool for round trips.
+func FormatBool(trueStr, falseStr string) TypedMapper {
+	return namedFunc[bool, string]("FormatBool", func(b bool) (string, error) {
+		if b {
+			return trueStr, nil
+		}
+		return falseStr, nil
+	})
+}
@@ -0,0 +1,107 @@
+package gomorph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlexibleBool(t *testing.T) {
+	defaults := gomorph.FlexibleBool(nil, nil)
+	yesNo := gomorph.FlexibleBool([]string{"Y", "T"}, []string{"N", "F"})
+
+	tests := []struct {
+		name     string
+		mapper   gomorph.TypedMapper
+		input    any
+		expected bool
+		wantErr  string
+	}{
+		{name: "default true string", mapper: defaults, input: "Yes", expected: true},
+		{name: "default false string", mapper: defaults, input: " off ", expected: false},
+		{name: "int one", mapper: defaults, input: 1, expected: true},
+		{name: "int zero", mapper: defaults, input: int64(0), expected: false},
+		{name: "uint one", mapper: defaults, input: uint8(1), expected: true},
+		{name: "bool passthrough", mapper: defaults, input: true, expected: true},
+		{name: "custom true token", mapper: yesNo, input: "y", expected: true},
+		{name: "custom false token", mapper: yesNo, input: "F", expected: false},
+		{name: "outside custom sets", mapper: yesNo, input: "yes", wantErr: `invalid boolean value "yes"`},
+		{name: "int outside sets", mapper: defaults, input: 2, wantErr: `invalid boolean value "2"`},
+		{name: "unsupported type", mapper: defaults, input: 1.0, wantErr: "cannot interpret float64 as a boolean"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.mapper.From(tt.input)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParseTristate(t *testing.T) {
+	defaults := gomorph.ParseTristate(nil, nil, nil)
+	survey := gomorph.ParseTristate([]string{"agree"}, []string{"disagree"}, []string{"no answer"})
+
+	yes, no := true, false
+	tests := []struct {
+		name     string
+		mapper   gomorph.TypedMapper
+		input    string
+		expected *bool
+		wantErr  string
+	}{
+		{name: "default true", mapper: defaults, input: "Yes", expected: &yes},
+		{name: "default false", mapper: defaults, input: " n ", expected: &no},
+		{name: "default unknown", mapper: defaults, input: "N/A", expected: nil},
+		{name: "default empty is unknown", mapper: defaults, input: "", expected: nil},
+		{name: "custom true", mapper: survey, input: "Agree", expected: &yes},
+		{name: "custom unknown", mapper: survey, input: "no answer", expected: nil},
+		{name: "unrecognized", mapper: survey, input: "maybe", wantErr: `invalid tristate value "maybe"`},
+		{name: "defaults not used with custom sets", mapper: survey, input: "", wantErr: `invalid tristate value ""`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.mapper.From(tt.input)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	assert.Equal(t, reflect.TypeOf((*bool)(nil)), defaults.TargetType())
+}
+
+func TestFormatBool(t *testing.T) {
+	yesNo := gomorph.FormatBool("Y", "N")
+
+	got, err := yesNo.From(true)
+	require.NoError(t, err)
+	assert.Equal(t, "Y", got)
+
+	got, err = yesNo.From(false)
+	require.NoError(t, err)
+	assert.Equal(t, "N", got)
+
+	_, err = yesNo.From("true")
+	assert.Error(t, err)
+
+	assert.Equal(t, reflect.TypeOf(true), yesNo.SourceType())
+	assert.Equal(t, reflect.TypeOf(""), yesNo.TargetType())
+
+	normalize := gomorph.NewChainedMapper[any, string](gomorph.FlexibleBool(nil, nil), gomorph.FormatBool("1", "0"))
+	got, err = normalize.Map("yes")
+	require.NoError(t, err)
+	assert.Equal(t, "1", got)
+}
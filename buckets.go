@@ -0,0 +1,47 @@
+package gomorph
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Bucketize maps a value to the label of the bucket it falls into (T -> string), for reporting
+// categories such as age bands. bounds must be strictly ascending and hold one more element than
+// labels: labels[i] covers bounds[i] <= v < bounds[i+1], and the last bucket also includes its
+// upper bound. Values below the first bound or above the last are an error rather than being
+// folded into the outer buckets, so out-of-range data is noticed. Invalid bounds or labels panic
+// on construction.
+//
+// Example:
+//
+//	gomorph.Bucketize([]int{0, 19, 36, 150}, []string{"0-18", "19-35", "36+"})
+func Bucketize[T cmp.Ordered](bounds []T, labels []string) TypedMapper {
+	if len(labels) == 0 {
+		panic("Bucketize: need at least one label")
+	}
+	if len(bounds) != len(labels)+1 {
+		panic(fmt.Sprintf("Bucketize: %d bounds cannot delimit %d labels, need %d", len(bounds), len(labels), len(labels)+1))
+	}
+	for i := 1; i < len(bounds); i++ {
+		if !cmp.Less(bounds[i-1], bounds[i]) {
+			panic(fmt.Sprintf("Bucketize: bounds must be strictly ascending, got %v before %v", bounds[i-1], bounds[i]))
+		}
+	}
+	bounds, labels = slices.Clone(bounds), slices.Clone(labels)
+
+	return namedFunc[T, string]("Bucketize", func(value T) (string, error) {
+		last := len(bounds) - 1
+		if cmp.Less(value, bounds[0]) || cmp.Less(bounds[last], value) {
+			return "", fmt.Errorf("value %v is outside the bucket range [%v, %v]", value, bounds[0], bounds[last])
+		}
+		// Find the first bound greater than value; it closes the bucket. The last bucket is inclusive.
+		i, _ := slices.BinarySearchFunc(bounds, value, func(bound, v T) int {
+			if cmp.Less(v, bound) {
+				return 1
+			}
+			return -1
+		})
+		return labels[min(i-1, len(labels)-1)], nil
+	})
+}
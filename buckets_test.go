@@ -0,0 +1,57 @@
+package gomorph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketize(t *testing.T) {
+	ages := gomorph.Bucketize([]int{0, 19, 36, 150}, []string{"0-18", "19-35", "36+"})
+
+	tests := []struct {
+		input    int
+		expected string
+		wantErr  string
+	}{
+		{input: 0, expected: "0-18"},
+		{input: 18, expected: "0-18"},
+		{input: 19, expected: "19-35"},
+		{input: 35, expected: "19-35"},
+		{input: 36, expected: "36+"},
+		{input: 150, expected: "36+"},
+		{input: -1, wantErr: "value -1 is outside the bucket range [0, 150]"},
+		{input: 151, wantErr: "value 151 is outside the bucket range [0, 150]"},
+	}
+	for _, tt := range tests {
+		got, err := ages.From(tt.input)
+		if tt.wantErr != "" {
+			assert.EqualError(t, err, tt.wantErr)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, got, "input %d", tt.input)
+	}
+
+	scores := gomorph.Bucketize([]float64{0, 0.5, 1}, []string{"low", "high"})
+	got, err := scores.From(0.75)
+	require.NoError(t, err)
+	assert.Equal(t, "high", got)
+	_, err = scores.From(math.NaN())
+	assert.Error(t, err)
+}
+
+func TestBucketize_InvalidConfiguration(t *testing.T) {
+	assert.PanicsWithValue(t, "Bucketize: need at least one label", func() {
+		gomorph.Bucketize([]int{5}, nil)
+	})
+	assert.PanicsWithValue(t, "Bucketize: 2 bounds cannot delimit 2 labels, need 3", func() {
+		gomorph.Bucketize([]int{0, 10}, []string{"a", "b"})
+	})
+	assert.PanicsWithValue(t, "Bucketize: bounds must be strictly ascending, got 10 before 10", func() {
+		gomorph.Bucketize([]int{0, 10, 10}, []string{"a", "b"})
+	})
+}
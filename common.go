@@ -1,5 +1,10 @@
 package gomorph
 
+import (
+	"fmt"
+	"reflect"
+)
+
 type IdentityMapper[T any] struct {
 	TypeMap[T, T]
 }
@@ -7,3 +12,126 @@ type IdentityMapper[T any] struct {
 func (m IdentityMapper[T]) From(source any) (any, error) {
 	return source.(T), nil
 }
+
+func (m IdentityMapper[T]) isIdentity() {}
+
+// identityMapper marks mappers that return their input unchanged, which lets chains made only of
+// such steps skip executing them.
+type identityMapper interface {
+	isIdentity()
+}
+
+// MapperFunc adapts a typed function into a TypedMapper. It performs the type assertion on the
+// incoming value so converter implementations only deal with concrete types, and derives
+// SourceType and TargetType from its type parameters, so a one-off conversion needs no struct of
+// its own.
+//
+// Example:
+//
+//	parse := gomorph.MapperFunc[string, int](strconv.Atoi)
+//	chain := gomorph.NewChainedMapper[string, int](parse)
+type MapperFunc[TSource, TDest any] func(TSource) (TDest, error)
+
+func (f MapperFunc[TSource, TDest]) From(source any) (any, error) {
+	typed, ok := source.(TSource)
+	if !ok && (source != nil || reflect.TypeFor[TSource]().Kind() != reflect.Interface) {
+		return *new(TDest), fmt.Errorf("expected %v, got %T", reflect.TypeFor[TSource](), source)
+	}
+	return f(typed)
+}
+
+func (f MapperFunc[TSource, TDest]) SourceType() reflect.Type {
+	return TypeMap[TSource, TDest]{}.SourceType()
+}
+
+func (f MapperFunc[TSource, TDest]) TargetType() reflect.Type {
+	return TypeMap[TSource, TDest]{}.TargetType()
+}
+
+// namedMapper gives a TypedMapper the name reported in chain errors and FieldReport steps, so the
+// built-in converters are identified by their constructor rather than as a MapperFunc.
+type namedMapper struct {
+	TypedMapper
+	name string
+}
+
+func (m namedMapper) Name() string {
+	return m.name
+}
+
+// namedFunc is MapperFunc for the constructors of this package, naming the step after the
+// constructor.
+func namedFunc[TSource, TDest any](name string, f func(TSource) (TDest, error)) TypedMapper {
+	return namedMapper{TypedMapper: MapperFunc[TSource, TDest](f), name: name}
+}
+
+// AssertType narrows an interface value to T with a checked type assertion (any -> T). It
+// formalizes the value.(T) pattern as a chain step, returning a descriptive error instead of
+// panicking when the dynamic type does not match.
+//
+// Example:
+//
+//	mapping := gomorph.From[any, string]("payload").
+//	    To("Name").
+//	    ConvertWith(gomorph.AssertType[string]()).
+//	    SkipValidation().
+//	    Build()
+func AssertType[T any]() TypedMapper {
+	return namedFunc[any, T]("AssertType", func(value any) (T, error) {
+		typed, ok := value.(T)
+		if !ok {
+			return typed, fmt.Errorf("type assertion failed: expected %v, got %T", reflect.TypeFor[T](), value)
+		}
+		return typed, nil
+	})
+}
+
+// AssertOrConvert behaves like AssertType but falls back to conv when the assertion fails,
+// which is handy when a field usually carries a T but occasionally arrives in another form,
+// e.g. a number encoded as a string. conv must produce T; this is checked on construction.
+func AssertOrConvert[T any](conv TypedMapper) TypedMapper {
+	target := reflect.TypeFor[T]()
+	if conv.TargetType() != target {
+		panic(fmt.Sprintf("AssertOrConvert: converter must produce %v, got %v", target, conv.TargetType()))
+	}
+
+	return namedFunc[any, T]("AssertOrConvert", func(value any) (T, error) {
+		if typed, ok := value.(T); ok {
+			return typed, nil
+		}
+
+		converted, err := conv.From(value)
+		if err != nil {
+			var zero T
+			return zero, fmt.Errorf("type assertion to %v failed and conversion from %T failed: %w", target, value, err)
+		}
+		typed, ok := converted.(T)
+		if !ok {
+			return typed, fmt.Errorf("type assertion failed: converter returned %T, expected %v", converted, target)
+		}
+		return typed, nil
+	})
+}
+
+// LookupOrKeep maps values through table and passes values without an entry through unchanged.
+// It captures best-effort normalization, such as mapping known country aliases to their ISO code
+// while leaving everything else as it was. The table is copied on construction.
+//
+// Example:
+//
+//	normalize := gomorph.LookupOrKeep(map[string]string{"UK": "GB", "USA": "US"})
+//	normalize.From("UK") // "GB"
+//	normalize.From("FR") // "FR"
+func LookupOrKeep[T comparable](table map[T]T) TypedMapper {
+	lookup := make(map[T]T, len(table))
+	for k, v := range table {
+		lookup[k] = v
+	}
+
+	return namedFunc[T, T]("LookupOrKeep", func(value T) (T, error) {
+		if mapped, ok := lookup[value]; ok {
+			return mapped, nil
+		}
+		return value, nil
+	})
+}
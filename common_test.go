@@ -0,0 +1,165 @@
+package gomorph_test
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Shape interface {
+	Area() float64
+}
+
+type Square struct{ Side float64 }
+
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+func TestMapperFunc(t *testing.T) {
+	parse := gomorph.MapperFunc[string, int](strconv.Atoi)
+	assert.Equal(t, reflect.TypeFor[string](), parse.SourceType())
+	assert.Equal(t, reflect.TypeFor[int](), parse.TargetType())
+
+	result, err := parse.From("42")
+	require.NoError(t, err)
+	assert.Equal(t, 42, result)
+
+	_, err = parse.From(42)
+	assert.EqualError(t, err, "expected string, got int")
+
+	chain := gomorph.NewChainedMapper[string, int](parse)
+	result, err = chain.Map("7")
+	require.NoError(t, err)
+	assert.Equal(t, 7, result)
+}
+
+func TestBuiltinStepNames(t *testing.T) {
+	mapping := gomorph.From[string, string]("InputString").
+		To("MappedInputString").
+		PreValidateWith(gomorph.RequirePrefix("tel:")).
+		ConvertWith(gomorph.NormalizeDigits()).
+		ValidateWith(gomorph.MaxBytes(4)).
+		Build()
+	mapper := gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{mapping})
+
+	_, err := mapper.From(Input{InputString: "tel:555-0100"})
+	assert.ErrorContains(t, err, "mapper chain failed at step 3 (MaxBytes)")
+
+	_, report, err := mapper.FromVerbose(Input{InputString: "tel:55-5"})
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	assert.Equal(t, []string{"RequirePrefix", "NormalizeDigits", "MaxBytes"}, report[0].Steps)
+
+	wrappers := []struct {
+		mapper gomorph.TypedMapper
+		want   string
+	}{
+		{mapper: gomorph.Dedup[string](), want: "Dedup"},
+		{mapper: gomorph.DedupBy(func(s string) int { return len(s) }), want: "DedupBy"},
+		{mapper: gomorph.DecimalConstraint(5, 2), want: "DecimalConstraint"},
+		{mapper: gomorph.DecimalConstraintOf(5, 2, func(s string) string { return s }), want: "DecimalConstraintOf"},
+		{mapper: gomorph.SHA256Hash(), want: "SHA256Hash"},
+		{mapper: gomorph.CRC32Hash(), want: "CRC32Hash"},
+		{mapper: gomorph.EmptyStringToNil(), want: "EmptyStringToNil"},
+		{mapper: gomorph.EmptyToNil[int](), want: "EmptyToNil"},
+	}
+	for _, tt := range wrappers {
+		named, ok := tt.mapper.(gomorph.Named)
+		require.True(t, ok, tt.want)
+		assert.Equal(t, tt.want, named.Name(), "steps are named after the constructor that was called")
+	}
+}
+
+func TestAssertType(t *testing.T) {
+	t.Run("narrows to a concrete type", func(t *testing.T) {
+		var shape Shape = Square{Side: 2}
+		got, err := gomorph.AssertType[Square]().From(shape)
+		require.NoError(t, err)
+		assert.Equal(t, Square{Side: 2}, got)
+	})
+
+	t.Run("narrows to an interface", func(t *testing.T) {
+		got, err := gomorph.AssertType[Shape]().From(Square{Side: 3})
+		require.NoError(t, err)
+		assert.Equal(t, 9.0, got.(Shape).Area())
+	})
+
+	t.Run("mismatch is a descriptive error", func(t *testing.T) {
+		_, err := gomorph.AssertType[string]().From(42)
+		assert.EqualError(t, err, "type assertion failed: expected string, got int")
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		_, err := gomorph.AssertType[string]().From(nil)
+		assert.EqualError(t, err, "type assertion failed: expected string, got <nil>")
+	})
+}
+
+func TestAssertOrConvert(t *testing.T) {
+	lengthOf := gomorph.AssertOrConvert[int](StringToIntMapper{})
+
+	got, err := lengthOf.From(7)
+	require.NoError(t, err)
+	assert.Equal(t, 7, got, "assertable values pass through")
+
+	got, err = lengthOf.From("hello")
+	require.NoError(t, err)
+	assert.Equal(t, 5, got, "other values go through the converter")
+
+	_, err = lengthOf.From(1.5)
+	assert.ErrorContains(t, err, "type assertion to int failed and conversion from float64 failed")
+
+	assert.PanicsWithValue(t, "AssertOrConvert: converter must produce string, got int", func() {
+		gomorph.AssertOrConvert[string](StringToIntMapper{})
+	})
+}
+
+func TestAssertType_NarrowsRecordValues(t *testing.T) {
+	fields := []gomorph.FieldMapper{
+		gomorph.From[any, string]("InputString").
+			To("SomeField").
+			ConvertWith(gomorph.AssertType[string]()).
+			SkipValidation().
+			Build(),
+	}
+	mapper := gomorph.NewStructMapper[map[string]any, SomeStruct](fields)
+
+	result, err := mapper.From(map[string]any{"InputString": "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, "hello", result.SomeField)
+
+	_, err = mapper.From(map[string]any{"InputString": 12})
+	assert.ErrorContains(t, err, fmt.Sprintf("mapping error [InputString]: mapper chain failed at step 1 (AssertType): %s",
+		"type assertion failed: expected string, got int"))
+}
+
+func TestLookupOrKeep(t *testing.T) {
+	table := map[string]string{"UK": "GB", "USA": "US"}
+	normalize := gomorph.LookupOrKeep(table)
+	table["FR"] = "XX"
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"UK", "GB"},
+		{"USA", "US"},
+		{"FR", "FR"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		got, err := normalize.From(tt.input)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "input %q", tt.input)
+	}
+
+	assert.Equal(t, reflect.TypeOf(""), normalize.SourceType())
+	assert.Equal(t, reflect.TypeOf(""), normalize.TargetType())
+
+	_, err := normalize.From(42)
+	assert.Error(t, err)
+}
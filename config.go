@@ -0,0 +1,76 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var _ AccessorFieldMapper = (*ConfigMapping[string])(nil)
+
+// ConfigMapping is a FieldMapper that writes a value taken from runtime configuration, such as
+// an environment variable or tenant setting, instead of from the source object. Create one with
+// FromConfig.
+type ConfigMapping[T any] struct {
+	lookup     func(string) (T, bool)
+	key        string
+	target     FieldDef[T]
+	defaultVal Optional[T]
+}
+
+// FromConfig maps the configuration value stored under key onto target. lookup is called on
+// every mapping, so gomorph never reads the environment itself; pass os.LookupEnv for
+// environment variables or a closure over injected settings. A missing key behaves like an
+// absent optional field: the target is left untouched, unless WithDefault supplies a value.
+//
+// Example:
+//
+//	gomorph.FromConfig(os.LookupEnv, "DEPLOY_REGION", gomorph.NewField[string]("Region"))
+func FromConfig[T any](lookup func(string) (T, bool), key string, target FieldDef[T]) *ConfigMapping[T] {
+	return &ConfigMapping[T]{
+		lookup: lookup,
+		key:    key,
+		target: target,
+	}
+}
+
+// WithDefault returns a copy of the mapping that writes value when the key is missing.
+func (m *ConfigMapping[T]) WithDefault(value T) *ConfigMapping[T] {
+	copied := *m
+	copied.defaultVal = Some(value)
+	return &copied
+}
+
+// From describes the configuration key as the source field.
+func (m *ConfigMapping[T]) From() Field {
+	return fieldInfo{name: m.key, typ: reflect.TypeFor[T]()}
+}
+
+func (m *ConfigMapping[T]) To() Field {
+	return m.target
+}
+
+// SourceAccessor ignores the source object and reads the key through lookup.
+func (m *ConfigMapping[T]) SourceAccessor() SourceAccessor {
+	return func(any) (any, error) {
+		if value, ok := m.lookup(m.key); ok {
+			return Some(value), nil
+		}
+		return None[T](), nil
+	}
+}
+
+// Map expects the Optional produced by SourceAccessor.
+func (m *ConfigMapping[T]) Map(value any) (FieldMappingResult, error) {
+	opt, ok := value.(Optional[T])
+	if !ok {
+		return NewFieldMappingResult(m.target, NewTypedValue(nil)),
+			fmt.Errorf("invalid source type: expected %v, got %T", reflect.TypeFor[Optional[T]](), value)
+	}
+	if v, present := opt.Value(); present {
+		return NewFieldMappingResult(m.target, NewTypedValue(v)), nil
+	}
+	if v, present := m.defaultVal.Value(); present {
+		return NewFieldMappingResult(m.target, NewTypedValue(v)), nil
+	}
+	return NewFieldMappingResult(m.target, NewTypedValue(nil)), ErrSkipField
+}
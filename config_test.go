@@ -0,0 +1,44 @@
+package gomorph_test
+
+import (
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type StampedOutput struct {
+	MappedInputString string
+	Region            string
+	Replicas          int
+}
+
+func TestFromConfig(t *testing.T) {
+	settings := map[string]string{"REGION": "eu-west-1"}
+	lookup := func(key string) (string, bool) {
+		v, ok := settings[key]
+		return v, ok
+	}
+	replicas := func(key string) (int, bool) { return 0, false }
+
+	mapper := gomorph.NewStructMapper[Input, StampedOutput]([]gomorph.FieldMapper{
+		gomorph.From[string, string]("InputString").To("MappedInputString").SkipConversion().SkipValidation().Build(),
+		gomorph.FromConfig(lookup, "REGION", gomorph.NewField[string]("Region")),
+		gomorph.FromConfig(replicas, "REPLICAS", gomorph.NewField[int]("Replicas")).WithDefault(3),
+	})
+
+	result, err := mapper.From(Input{InputString: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, StampedOutput{MappedInputString: "a", Region: "eu-west-1", Replicas: 3}, result)
+
+	settings["REGION"] = "us-east-1"
+	result, err = mapper.From(Input{InputString: "b"})
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", result.Region, "lookup is called on every mapping")
+
+	delete(settings, "REGION")
+	result, err = mapper.From(Input{InputString: "c"})
+	require.NoError(t, err)
+	assert.Equal(t, "", result.Region, "missing keys without a default leave the target untouched")
+}
@@ -0,0 +1,106 @@
+package gomorph
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ParseCSVLine splits a single CSV record into its fields using encoding/csv, so quoted fields
+// may contain the delimiter, escaped quotes ("") and even line breaks:
+//
+//	a,"b,c",d       -> ["a", "b,c", "d"]
+//	"say ""hi""",x  -> [`say "hi"`, "x"]
+//
+// An empty input yields an empty slice. Input holding more than one record is rejected, as are
+// malformed quotes, with the csv.ParseError wrapped for context.
+func ParseCSVLine() TypedMapper {
+	return namedFunc[string, []string]("ParseCSVLine", func(s string) ([]string, error) {
+		reader := csv.NewReader(strings.NewReader(s))
+		reader.FieldsPerRecord = -1
+
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return []string{}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse csv line %q: %w", s, err)
+		}
+
+		if _, err := reader.Read(); !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("parse csv line %q: expected a single record", s)
+		}
+		return record, nil
+	})
+}
+
+// FixedWidthField names a column of a fixed-width record by its zero-based byte offset and
+// length.
+type FixedWidthField struct {
+	Name  string
+	Start int
+	Len   int
+}
+
+// ParseFixedWidth slices a fixed-width record into named fields (string -> map[string]string),
+// ready to be mapped onto a struct by a StructMapper. Offsets are in bytes and the space padding
+// around each value is trimmed. A record too short for a field is an error naming the field.
+// Negative offsets or lengths are programming errors and panic on construction.
+func ParseFixedWidth(fields []FixedWidthField) TypedMapper {
+	columns := make([]FixedWidthField, len(fields))
+	for i, f := range fields {
+		if f.Start < 0 || f.Len < 0 {
+			panic(fmt.Sprintf("ParseFixedWidth: field %q has negative start or length", f.Name))
+		}
+		columns[i] = f
+	}
+
+	return namedFunc[string, map[string]string]("ParseFixedWidth", func(s string) (map[string]string, error) {
+		result := make(map[string]string, len(columns))
+		for _, f := range columns {
+			end := f.Start + f.Len
+			if end > len(s) {
+				return nil, fmt.Errorf("fixed-width field %q spans bytes %d-%d, but the record is only %d bytes long", f.Name, f.Start, end, len(s))
+			}
+			result[f.Name] = strings.Trim(s[f.Start:end], " ")
+		}
+		return result, nil
+	})
+}
+
+// SplitAuto splits a list whose separator is not known in advance (string -> []string), such as
+// data mixing "a,b,c" and "a;b;c". The candidate occurring most often in the input is used as the
+// separator; ties go to the candidate listed first, so the order of candidates expresses
+// preference. Input containing none of the candidates is a single field. Fields are trimmed of
+// surrounding whitespace and an empty input yields an empty slice.
+//
+//	SplitAuto([]rune{',', ';'}): "a;b;c"      -> ["a", "b", "c"]
+//	                             "a,b; c,d"   -> ["a", "b; c", "d"]
+//	                             "x,y;z"      -> ["x", "y;z"]
+func SplitAuto(candidates []rune) TypedMapper {
+	candidates = slices.Clone(candidates)
+	return namedFunc[string, []string]("SplitAuto", func(s string) ([]string, error) {
+		if strings.TrimSpace(s) == "" {
+			return []string{}, nil
+		}
+
+		sep, best := rune(0), 0
+		for _, c := range candidates {
+			if n := strings.Count(s, string(c)); n > best {
+				sep, best = c, n
+			}
+		}
+		if best == 0 {
+			return []string{strings.TrimSpace(s)}, nil
+		}
+
+		fields := strings.Split(s, string(sep))
+		for i, f := range fields {
+			fields[i] = strings.TrimSpace(f)
+		}
+		return fields, nil
+	})
+}
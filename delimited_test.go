@@ -0,0 +1,124 @@
+package gomorph_test
+
+import (
+	"encoding/csv"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSVLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+		wantErr  string
+	}{
+		{name: "plain fields", input: "a,b,c", expected: []string{"a", "b", "c"}},
+		{name: "quoted delimiter", input: `a,"b,c",d`, expected: []string{"a", "b,c", "d"}},
+		{name: "escaped quotes", input: `"say ""hi""",x`, expected: []string{`say "hi"`, "x"}},
+		{name: "empty fields", input: "a,,c", expected: []string{"a", "", "c"}},
+		{name: "empty input", input: "", expected: []string{}},
+		{name: "unterminated quote", input: `a,"b`, wantErr: `parse csv line "a,\"b"`},
+		{name: "multiple records", input: "a,b\nc,d", wantErr: "expected a single record"},
+	}
+
+	mapper := gomorph.ParseCSVLine()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapper.From(tt.input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParseCSVLine_WrapsParseError(t *testing.T) {
+	_, err := gomorph.ParseCSVLine().From(`"a"b`)
+
+	var parseErr *csv.ParseError
+	require.ErrorAs(t, err, &parseErr)
+}
+
+type MainframeRecord struct {
+	ID   string
+	Name string
+	City string
+}
+
+func TestParseFixedWidth(t *testing.T) {
+	parse := gomorph.ParseFixedWidth([]gomorph.FixedWidthField{
+		{Name: "id", Start: 0, Len: 5},
+		{Name: "name", Start: 5, Len: 10},
+		{Name: "city", Start: 15, Len: 8},
+	})
+
+	got, err := parse.From("00042Ada Lovel London  ")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"id": "00042", "name": "Ada Lovel", "city": "London"}, got)
+
+	_, err = parse.From("00042Ada")
+	assert.EqualError(t, err, `fixed-width field "name" spans bytes 5-15, but the record is only 8 bytes long`)
+
+	assert.Panics(t, func() {
+		gomorph.ParseFixedWidth([]gomorph.FixedWidthField{{Name: "bad", Start: -1, Len: 2}})
+	})
+}
+
+func TestParseFixedWidth_IntoStruct(t *testing.T) {
+	copyField := func(from, to string) gomorph.FieldMapper {
+		return gomorph.From[string, string](from).To(to).SkipConversion().SkipValidation().Build()
+	}
+	toRecord := gomorph.NewStructMapper[map[string]string, MainframeRecord]([]gomorph.FieldMapper{
+		copyField("id", "ID"),
+		copyField("name", "Name"),
+		copyField("city", "City"),
+	})
+
+	fields, err := gomorph.ParseFixedWidth([]gomorph.FixedWidthField{
+		{Name: "id", Start: 0, Len: 3},
+		{Name: "name", Start: 3, Len: 6},
+		{Name: "city", Start: 9, Len: 5},
+	}).From("007Bond  Paris")
+	require.NoError(t, err)
+
+	record, err := toRecord.From(fields.(map[string]string))
+	require.NoError(t, err)
+	assert.Equal(t, MainframeRecord{ID: "007", Name: "Bond", City: "Paris"}, record)
+}
+
+func TestSplitAuto(t *testing.T) {
+	split := gomorph.SplitAuto([]rune{',', ';'})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{name: "commas", input: "a,b,c", expected: []string{"a", "b", "c"}},
+		{name: "semicolons", input: "a; b; c", expected: []string{"a", "b", "c"}},
+		{name: "majority wins", input: "a,b; c,d", expected: []string{"a", "b; c", "d"}},
+		{name: "tie goes to first candidate", input: "x,y;z", expected: []string{"x", "y;z"}},
+		{name: "no separator", input: " single ", expected: []string{"single"}},
+		{name: "empty", input: "", expected: []string{}},
+		{name: "empty fields kept", input: "a,,b", expected: []string{"a", "", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := split.From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	got, err := gomorph.SplitAuto([]rune{';', ','}).From("x,y;z")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"x,y", "z"}, got, "candidate order sets the preference")
+}
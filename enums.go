@@ -0,0 +1,192 @@
+package gomorph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type missAction int
+
+const (
+	missError missAction = iota
+	missSkip
+	missDefault
+)
+
+// MissPolicy decides what an enum lookup does with a value that has no entry: report an error,
+// skip it, or substitute a default. Build one with MissError, MissSkip or MissDefault; the zero
+// value behaves like MissError.
+type MissPolicy[T any] struct {
+	action       missAction
+	defaultValue T
+}
+
+// MissError makes unknown values an error.
+func MissError[T any]() MissPolicy[T] {
+	return MissPolicy[T]{action: missError}
+}
+
+// MissSkip drops unknown values.
+func MissSkip[T any]() MissPolicy[T] {
+	return MissPolicy[T]{action: missSkip}
+}
+
+// MissDefault replaces unknown values with value.
+func MissDefault[T any](value T) MissPolicy[T] {
+	return MissPolicy[T]{action: missDefault, defaultValue: value}
+}
+
+// ParseEnumList splits a delimited string and maps every token through table (string -> []T), e.g.
+// "wizard, warrior" -> []CharacterClass{Wizard, Warrior}. Tokens are trimmed before lookup and
+// unknown tokens are handled by miss; errors name the token and its 1-based position. An empty
+// input yields an empty slice.
+func ParseEnumList[T comparable](sep string, table map[string]T, miss MissPolicy[T]) TypedMapper {
+	lookup := make(map[string]T, len(table))
+	for k, v := range table {
+		lookup[k] = v
+	}
+
+	return namedFunc[string, []T]("ParseEnumList", func(value string) ([]T, error) {
+		if strings.TrimSpace(value) == "" {
+			return []T{}, nil
+		}
+
+		tokens := strings.Split(value, sep)
+		result := make([]T, 0, len(tokens))
+		for i, token := range tokens {
+			token = strings.TrimSpace(token)
+			if v, ok := lookup[token]; ok {
+				result = append(result, v)
+				continue
+			}
+			switch miss.action {
+			case missSkip:
+			case missDefault:
+				result = append(result, miss.defaultValue)
+			default:
+				return nil, fmt.Errorf("unknown enum value %q at position %d", token, i+1)
+			}
+		}
+		return result, nil
+	})
+}
+
+// AliasMapper normalizes the many spellings an enum value arrives in to one canonical value, and
+// renders canonical values in the preferred spelling of an output system. As a TypedMapper
+// (string -> string) it resolves input: a value listed in aliases maps to its canonical value and
+// a canonical value maps to itself. Inverse provides the reverse direction via canonical. Values
+// that cannot be resolved in either direction follow the miss policy; MissSkip returns
+// ErrSkipField so the target field is left untouched.
+//
+// Example:
+//
+//	classes := gomorph.NewAliasMapper(
+//	    map[string]string{"wizard": "Wizard", "mage": "Wizard", "fighter": "Warrior"},
+//	    map[string]string{"Wizard": "WIZ", "Warrior": "WAR"},
+//	    gomorph.MissError[string](),
+//	)
+//	classes.From("mage")            // "Wizard"
+//	classes.Inverse().From("Wizard") // "WIZ"
+type AliasMapper struct {
+	TypeMap[string, string]
+	aliases   map[string]string
+	canonical map[string]string
+	miss      MissPolicy[string]
+}
+
+func NewAliasMapper(aliases, canonical map[string]string, miss MissPolicy[string]) *AliasMapper {
+	m := &AliasMapper{
+		aliases:   make(map[string]string, len(aliases)),
+		canonical: make(map[string]string, len(canonical)),
+		miss:      miss,
+	}
+	for alias, value := range aliases {
+		m.aliases[alias] = value
+	}
+	for value, output := range canonical {
+		m.canonical[value] = output
+	}
+	return m
+}
+
+// Name reports the constructor in chain errors and FieldReport steps.
+func (m *AliasMapper) Name() string {
+	return "NewAliasMapper"
+}
+
+func (m *AliasMapper) From(source any) (any, error) {
+	s, ok := source.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected string, got %T", source)
+	}
+	if value, ok := m.aliases[s]; ok {
+		return value, nil
+	}
+	if _, ok := m.canonical[s]; ok {
+		return s, nil
+	}
+	return m.onMiss(s, "alias")
+}
+
+// Inverse returns the reverse mapping (string -> string), from a canonical value to its
+// preferred output spelling.
+func (m *AliasMapper) Inverse() TypedMapper {
+	return namedFunc[string, string]("AliasMapper.Inverse", func(s string) (string, error) {
+		if output, ok := m.canonical[s]; ok {
+			return output, nil
+		}
+		value, err := m.onMiss(s, "canonical value")
+		if err != nil {
+			return "", err
+		}
+		return value.(string), nil
+	})
+}
+
+func (m *AliasMapper) onMiss(s, what string) (any, error) {
+	switch m.miss.action {
+	case missSkip:
+		return nil, ErrSkipField
+	case missDefault:
+		return m.miss.defaultValue, nil
+	default:
+		return nil, fmt.Errorf("unknown %s %q", what, s)
+	}
+}
+
+// OrdinalMapper maps a value of an ordered category to its position in order (string -> int), so
+// "low", "medium" and "high" become 0, 1 and 2 and can be compared or stored as integers. Values
+// not in order are an error. order is copied; duplicate entries panic.
+//
+// Example:
+//
+//	severity := []string{"low", "medium", "high"}
+//	gomorph.OrdinalMapper(severity).From("high") // 2
+func OrdinalMapper(order []string) TypedMapper {
+	index := make(map[string]int, len(order))
+	for i, value := range order {
+		if _, dup := index[value]; dup {
+			panic(fmt.Sprintf("OrdinalMapper: duplicate value %q in order", value))
+		}
+		index[value] = i
+	}
+	return namedFunc[string, int]("OrdinalMapper", func(s string) (int, error) {
+		if i, ok := index[s]; ok {
+			return i, nil
+		}
+		return 0, fmt.Errorf("unknown ordinal value %q", s)
+	})
+}
+
+// IndexToOrdinal is the inverse of OrdinalMapper (int -> string). Indexes outside order are an
+// error. order is copied.
+func IndexToOrdinal(order []string) TypedMapper {
+	order = slices.Clone(order)
+	return namedFunc[int, string]("IndexToOrdinal", func(i int) (string, error) {
+		if i < 0 || i >= len(order) {
+			return "", fmt.Errorf("ordinal index %d out of range [0, %d)", i, len(order))
+		}
+		return order[i], nil
+	})
+}
@@ -0,0 +1,137 @@
+package gomorph_test
+
+import (
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var classTable = map[string]CharacterClass{
+	"wizard":  "Wizard",
+	"warrior": "Warrior",
+	"rogue":   "Rogue",
+}
+
+func TestParseEnumList(t *testing.T) {
+	tests := []struct {
+		name     string
+		miss     gomorph.MissPolicy[CharacterClass]
+		input    string
+		expected []CharacterClass
+		wantErr  string
+	}{
+		{name: "all known", miss: gomorph.MissError[CharacterClass](), input: "wizard, warrior,rogue", expected: []CharacterClass{"Wizard", "Warrior", "Rogue"}},
+		{name: "empty input", miss: gomorph.MissError[CharacterClass](), input: "  ", expected: []CharacterClass{}},
+		{name: "unknown errors", miss: gomorph.MissError[CharacterClass](), input: "wizard,bard", wantErr: `unknown enum value "bard" at position 2`},
+		{name: "zero policy errors", input: "bard", wantErr: `unknown enum value "bard" at position 1`},
+		{name: "unknown skipped", miss: gomorph.MissSkip[CharacterClass](), input: "bard,rogue", expected: []CharacterClass{"Rogue"}},
+		{name: "unknown defaulted", miss: gomorph.MissDefault[CharacterClass]("Commoner"), input: "bard,rogue", expected: []CharacterClass{"Commoner", "Rogue"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := gomorph.ParseEnumList(",", classTable, tt.miss).From(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func newClassAliases(miss gomorph.MissPolicy[string]) *gomorph.AliasMapper {
+	return gomorph.NewAliasMapper(
+		map[string]string{"wizard": "Wizard", "mage": "Wizard", "fighter": "Warrior"},
+		map[string]string{"Wizard": "WIZ", "Warrior": "WAR"},
+		miss,
+	)
+}
+
+func TestAliasMapper(t *testing.T) {
+	classes := newClassAliases(gomorph.MissError[string]())
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"wizard", "Wizard"},
+		{"mage", "Wizard"},
+		{"fighter", "Warrior"},
+		{"Warrior", "Warrior"},
+	}
+	for _, tt := range tests {
+		got, err := classes.From(tt.input)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, got, "input %q", tt.input)
+	}
+
+	_, err := classes.From("bard")
+	assert.EqualError(t, err, `unknown alias "bard"`)
+
+	got, err := classes.Inverse().From("Wizard")
+	require.NoError(t, err)
+	assert.Equal(t, "WIZ", got)
+
+	_, err = classes.Inverse().From("mage")
+	assert.EqualError(t, err, `unknown canonical value "mage"`, "aliases are not canonical values")
+}
+
+func TestAliasMapper_MissPolicies(t *testing.T) {
+	_, err := newClassAliases(gomorph.MissSkip[string]()).From("bard")
+	assert.ErrorIs(t, err, gomorph.ErrSkipField)
+
+	got, err := newClassAliases(gomorph.MissDefault("Commoner")).From("bard")
+	require.NoError(t, err)
+	assert.Equal(t, "Commoner", got)
+
+	got, err = newClassAliases(gomorph.MissDefault("UNK")).Inverse().From("Bard")
+	require.NoError(t, err)
+	assert.Equal(t, "UNK", got)
+}
+
+func TestAliasMapper_InChain(t *testing.T) {
+	normalize := newClassAliases(gomorph.MissError[string]())
+	toExternal := gomorph.NewChainedMapper[string, string](normalize, normalize.Inverse())
+
+	got, err := toExternal.Map("mage")
+	require.NoError(t, err)
+	assert.Equal(t, "WIZ", got)
+
+	_, err = toExternal.Map("bard")
+	assert.ErrorContains(t, err, "mapper chain failed at step 1 (NewAliasMapper)")
+}
+
+func TestOrdinalMapper(t *testing.T) {
+	severity := []string{"low", "medium", "high"}
+	toIndex := gomorph.OrdinalMapper(severity)
+	toValue := gomorph.IndexToOrdinal(severity)
+
+	for i, value := range severity {
+		got, err := toIndex.From(value)
+		require.NoError(t, err)
+		assert.Equal(t, i, got)
+
+		back, err := toValue.From(i)
+		require.NoError(t, err)
+		assert.Equal(t, value, back)
+	}
+
+	_, err := toIndex.From("critical")
+	assert.EqualError(t, err, `unknown ordinal value "critical"`)
+
+	_, err = toValue.From(3)
+	assert.EqualError(t, err, "ordinal index 3 out of range [0, 3)")
+	_, err = toValue.From(-1)
+	assert.EqualError(t, err, "ordinal index -1 out of range [0, 3)")
+
+	severity[0] = "trivial"
+	back, err := toValue.From(0)
+	require.NoError(t, err)
+	assert.Equal(t, "low", back, "order is copied")
+
+	assert.Panics(t, func() { gomorph.OrdinalMapper([]string{"a", "b", "a"}) })
+}
@@ -1,17 +1,85 @@
 package gomorph
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrSkipField can be returned (optionally wrapped) by any step of a field's chain to signal that
+// the field should not be written. StructMapper then leaves the destination field untouched and
+// continues with the next field instead of treating the mapping as failed. It is the mechanism
+// for value-driven omission, e.g. a converter that skips placeholder values like "N/A".
+var ErrSkipField = errors.New("skip field")
+
+// ErrSkipElement can be returned (optionally wrapped) by the element mapper of a SliceMapper to
+// drop that element from the output, e.g. to skip blank rows. The remaining elements keep their
+// order and the output has no gaps.
+var ErrSkipElement = errors.New("skip element")
+
+// ErrFieldNotFound is matched by the error returned when a source field cannot be read by any of
+// the configured accessors, letting callers tell absent fields apart from failed conversions.
+var ErrFieldNotFound = errors.New("field not found")
+
+// ErrAmbiguousField is matched by the error returned when a field name is promoted from more than
+// one embedded struct at the same depth, so neither can be read or written by that name.
+var ErrAmbiguousField = errors.New("ambiguous field")
+
+// FieldNotFoundError reports that no accessor could read Name from a source of type Type. It
+// matches ErrFieldNotFound with errors.Is.
+type FieldNotFoundError struct {
+	Name string
+	Type reflect.Type
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return fmt.Sprintf("field or zero-arg getter %q not found on %v", e.Name, e.Type)
+}
+
+func (e *FieldNotFoundError) Is(target error) bool {
+	return target == ErrFieldNotFound
+}
+
+// Codes carried by ValidationErrors produced by the built-in converters and validators, so
+// callers can react to a failure without matching on its message.
+const (
+	CodeInvalidEmail   = "invalid_email"
+	CodeInvalidDecimal = "invalid_decimal"
+	CodeDecimalRange   = "decimal_out_of_range"
+	CodeNotNumeric     = "not_numeric"
+	CodeMissingPrefix  = "missing_prefix"
+	CodeMissingSuffix  = "missing_suffix"
+	CodeInvalidUTF8    = "invalid_utf8"
+	CodeNotAllowed     = "not_allowed"
+	CodeTooLong        = "too_long"
+	CodeInvalidJSON    = "invalid_json"
+	CodeNilElement     = "nil_element"
+	CodeBadChecksum    = "bad_checksum"
+	CodeDateRange      = "date_out_of_range"
+)
 
 type ValidationError struct {
 	Field   string
 	Value   any
 	Message string
+	Code    string
 }
 
 func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("validation failed: %s", e.Message)
+	}
 	return fmt.Sprintf("validation failed for field %q: %s", e.Field, e.Message)
 }
 
+// WithCode sets a machine-readable code on the error and returns it for chaining.
+func (e *ValidationError) WithCode(code string) *ValidationError {
+	e.Code = code
+	return e
+}
+
 func NewValidationError(field string, value any, message string) *ValidationError {
 	return &ValidationError{
 		Field:   field,
@@ -19,3 +87,100 @@ func NewValidationError(field string, value any, message string) *ValidationErro
 		Message: message,
 	}
 }
+
+// StructValidationError is returned when a mapped struct fails validation after all of its
+// fields have been assigned. The underlying validator error is available through errors.As
+// and errors.Unwrap.
+type StructValidationError struct {
+	Type reflect.Type
+	Err  error
+}
+
+func (e *StructValidationError) Error() string {
+	return fmt.Sprintf("struct validation failed for %v: %v", e.Type, e.Err)
+}
+
+func (e *StructValidationError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors is returned by a StructMapper built with CollectFieldErrors when one or more fields
+// fail. It holds each field's input, mapping or output error in mapping order; each names its
+// field. Unwrap exposes the individual errors to errors.Is and errors.As.
+type FieldErrors []error
+
+func (e FieldErrors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, err := range e {
+		parts = append(parts, err.Error())
+	}
+	return fmt.Sprintf("%d field(s) failed: %s", len(e), strings.Join(parts, "; "))
+}
+
+func (e FieldErrors) Unwrap() []error {
+	return e
+}
+
+// KeyErrors collects failures by record key so every bad key is reported at once rather than only
+// the first. Error lists keys in sorted order and Unwrap exposes the individual errors to
+// errors.Is and errors.As.
+type KeyErrors map[string]error
+
+func (e KeyErrors) Error() string {
+	keys := e.keys()
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s: %v", key, e[key]))
+	}
+	return fmt.Sprintf("%d key(s) failed: %s", len(keys), strings.Join(parts, "; "))
+}
+
+func (e KeyErrors) Unwrap() []error {
+	keys := e.keys()
+	errs := make([]error, 0, len(keys))
+	for _, key := range keys {
+		errs = append(errs, e[key])
+	}
+	return errs
+}
+
+func (e KeyErrors) keys() []string {
+	keys := make([]string, 0, len(e))
+	for key := range e {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IndexErrors collects failures by position when a batch of inputs is mapped, so every bad item
+// is reported at once. Error lists indexes in ascending order and Unwrap exposes the individual
+// errors to errors.Is and errors.As.
+type IndexErrors map[int]error
+
+func (e IndexErrors) Error() string {
+	indexes := e.indexes()
+	parts := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		parts = append(parts, fmt.Sprintf("%d: %v", i, e[i]))
+	}
+	return fmt.Sprintf("%d item(s) failed: %s", len(indexes), strings.Join(parts, "; "))
+}
+
+func (e IndexErrors) Unwrap() []error {
+	indexes := e.indexes()
+	errs := make([]error, 0, len(indexes))
+	for _, i := range indexes {
+		errs = append(errs, e[i])
+	}
+	return errs
+}
+
+func (e IndexErrors) indexes() []int {
+	indexes := make([]int, 0, len(e))
+	for i := range e {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
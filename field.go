@@ -36,11 +36,27 @@ func (f FieldDef[T]) Type() reflect.Type {
 	return f.typ
 }
 
+// fieldInfo is a Field whose type is only known at runtime, used by mappers that are assembled
+// dynamically rather than through FieldDef's type parameter.
+type fieldInfo struct {
+	name string
+	typ  reflect.Type
+}
+
+func (f fieldInfo) Name() string {
+	return f.name
+}
+
+func (f fieldInfo) Type() reflect.Type {
+	return f.typ
+}
+
 // TypedValue is a value that has a type.
 // It represents a data value and its type.
 type TypedValue struct {
 	value any
 	typ   reflect.Type
+	meta  map[string]any
 }
 
 func NewTypedValue(value any) TypedValue {
@@ -50,6 +66,39 @@ func NewTypedValue(value any) TypedValue {
 	}
 }
 
+// NewTypedValueOf creates a TypedValue with an explicit type. Unlike NewTypedValue it can carry
+// a type for an untyped nil value, e.g. the declared type of the target field.
+func NewTypedValueOf(value any, typ reflect.Type) TypedValue {
+	return TypedValue{
+		value: value,
+		typ:   typ,
+	}
+}
+
+// WithMeta returns a copy of the value with key set to val in its metadata. Metadata lets
+// pipelines attach information such as provenance or validation state to a mapped value.
+func (v TypedValue) WithMeta(key string, val any) TypedValue {
+	meta := make(map[string]any, len(v.meta)+1)
+	for k, existing := range v.meta {
+		meta[k] = existing
+	}
+	meta[key] = val
+	v.meta = meta
+	return v
+}
+
+// Meta returns the metadata stored under key.
+func (v TypedValue) Meta(key string) (any, bool) {
+	val, ok := v.meta[key]
+	return val, ok
+}
+
+// ValueWrapper turns a mapped value into the TypedValue stored in a FieldMappingResult. It
+// receives the mapped value and the declared type of the target field. A wrapper may attach
+// metadata or pin the declared type, but the returned TypedValue must hold the mapped value
+// with either its dynamic type or the declared target type.
+type ValueWrapper func(value any, declared reflect.Type) TypedValue
+
 func (v TypedValue) Value() any {
 	return v.value
 }
@@ -74,9 +123,18 @@ func UnwrapAs[T any](fm FieldMappingResult) T {
 	return fm.MappedValue().Value().(T)
 }
 
+// TryUnwrapAs is the non-panicking counterpart of UnwrapAs: it returns the mapped value as T and
+// true, or the zero T and false when the value does not hold a T.
+func TryUnwrapAs[T any](fm FieldMappingResult) (T, bool) {
+	value, ok := fm.MappedValue().Value().(T)
+	return value, ok
+}
+
 type FieldMappingResult struct {
 	targetField Field
 	mappedValue TypedValue
+	status      FieldStatus
+	steps       []string
 }
 
 func (r FieldMappingResult) TargetField() Field {
@@ -87,6 +145,22 @@ func (r FieldMappingResult) MappedValue() TypedValue {
 	return r.mappedValue
 }
 
+// Status reports how the value was produced. Results built with NewFieldMappingResult report
+// FieldMapped.
+func (r FieldMappingResult) Status() FieldStatus {
+	return r.status
+}
+
+// Steps returns the names of the chain steps applied to produce the value, in order.
+func (r FieldMappingResult) Steps() []string {
+	return r.steps
+}
+
+// Raw returns the mapped value without any type assertion, for logging and generic handling.
+func (r FieldMappingResult) Raw() any {
+	return r.mappedValue.Value()
+}
+
 func NewFieldMappingResult(targetField Field, value TypedValue) FieldMappingResult {
 	return FieldMappingResult{
 		targetField: targetField,
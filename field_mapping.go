@@ -2,6 +2,7 @@ package gomorph
 
 import (
 	"fmt"
+	"reflect"
 )
 
 // FieldMapper represents an abstract transformation between two fields of potentially different types.
@@ -11,6 +12,70 @@ type FieldMapper interface {
 	Map(value any) (FieldMappingResult, error)
 }
 
+// MultiFieldMapper is a FieldMapper that writes several target fields from a single source
+// value. StructMapper assigns every result returned by MapAll; To only describes the targets as a
+// whole and Map is not used.
+type MultiFieldMapper interface {
+	FieldMapper
+	Targets() []Field
+	MapAll(value any) ([]FieldMappingResult, error)
+}
+
+// GatingFieldMapper is implemented by FieldMappers that can be marked as gating. A StructMapper
+// maps gating fields first and treats their failure as fatal for the whole struct.
+type GatingFieldMapper interface {
+	FieldMapper
+	IsGating() bool
+}
+
+// OptionalFieldMapper is implemented by FieldMappers that can be marked as optional. When the
+// source of an optional mapping is not found, StructMapper leaves the target field untouched and
+// moves on instead of failing.
+type OptionalFieldMapper interface {
+	FieldMapper
+	IsOptional() bool
+}
+
+// MetadataFieldMapper is implemented by FieldMappers that carry descriptive labels.
+type MetadataFieldMapper interface {
+	FieldMapper
+	Metadata() map[string]string
+}
+
+// NamedFieldMapper is implemented by FieldMappers that carry a name for diagnostics. The name
+// appears in StructMapper errors and Describe output.
+type NamedFieldMapper interface {
+	FieldMapper
+	Name() string
+}
+
+// DefaultingFieldMapper is implemented by FieldMappers that can supply a value for a missing source
+// field. When the source cannot be read because the field is not found, StructMapper assigns the
+// default, if ok is true, instead of failing; the mapper's chain is not run.
+type DefaultingFieldMapper interface {
+	FieldMapper
+	Default() (value any, ok bool)
+}
+
+// SourceAccessor reads the input of a mapping from the whole source object. It replaces the
+// default lookup of the mapping's From field by name.
+type SourceAccessor func(source any) (any, error)
+
+// AccessorFieldMapper is implemented by FieldMappers that can read their input with a custom
+// SourceAccessor. A nil accessor means the default lookup by From().Name().
+type AccessorFieldMapper interface {
+	FieldMapper
+	SourceAccessor() SourceAccessor
+}
+
+// AssigningFieldMapper is implemented by FieldMappers that write their result into the
+// destination themselves rather than having StructMapper set the target field by name. dest is a
+// pointer to the destination struct.
+type AssigningFieldMapper interface {
+	FieldMapper
+	Assign(dest any, value any) error
+}
+
 // FieldMapping defines how a value from a source field is transformed and assigned to a target field.
 // It links a source field definition, a destination field definition, and a ChainedMapper that performs
 // the actual data transformation.
@@ -35,6 +100,20 @@ type FieldMapping[TSource, TDest any] struct {
 	from  FieldDef[TSource]
 	to    FieldDef[TDest]
 	using *ChainedMapper[TSource, TDest]
+
+	name     string
+	gating   bool
+	optional bool
+	metadata map[string]string
+	wrap     ValueWrapper
+	read     SourceAccessor
+	when     func(TSource) bool
+
+	defaultValue TDest
+	hasDefault   bool
+
+	// validateOnly is set by the builder when the chain holds validators but no converter.
+	validateOnly bool
 }
 
 func (fm FieldMapping[TSource, TDest]) Using() *ChainedMapper[TSource, TDest] {
@@ -53,6 +132,52 @@ func NewFieldMapping[TSource, TDest any](
 	}
 }
 
+// Name returns the name set with the builder's Named step, or the target field name when the
+// mapping was not named.
+func (fm FieldMapping[TSource, TDest]) Name() string {
+	if fm.name != "" {
+		return fm.name
+	}
+	return fm.to.Name()
+}
+
+// IsGating reports whether the mapping was marked as gating with the builder's Gating step.
+func (fm FieldMapping[TSource, TDest]) IsGating() bool {
+	return fm.gating
+}
+
+// IsOptional reports whether the mapping was marked as optional with the builder's Optional step.
+func (fm FieldMapping[TSource, TDest]) IsOptional() bool {
+	return fm.optional
+}
+
+// Default returns the value set with the builder's WithDefault step, and whether one was set.
+func (fm FieldMapping[TSource, TDest]) Default() (any, bool) {
+	if !fm.hasDefault {
+		return nil, false
+	}
+	return fm.defaultValue, true
+}
+
+// Metadata returns a copy of the labels attached with the builder's WithMetadata step, or nil when
+// there are none.
+func (fm FieldMapping[TSource, TDest]) Metadata() map[string]string {
+	if fm.metadata == nil {
+		return nil
+	}
+	metadata := make(map[string]string, len(fm.metadata))
+	for k, v := range fm.metadata {
+		metadata[k] = v
+	}
+	return metadata
+}
+
+// SourceAccessor returns the accessor set with the builder's ReadWith step, or nil when the
+// source field is looked up by name.
+func (fm FieldMapping[TSource, TDest]) SourceAccessor() SourceAccessor {
+	return fm.read
+}
+
 func (fm FieldMapping[TSource, TDest]) From() Field {
 	return fm.from
 }
@@ -71,13 +196,21 @@ func (fm FieldMapping[TSource, TDest]) mapTyped(value TSource) (TDest, error) {
 
 func (fm FieldMapping[TSource, TDest]) Map(value any) (FieldMappingResult, error) {
 	castedValue, ok := value.(TSource)
-	if !ok {
-		err := fmt.Errorf("invalid source type: expected %T, got %T", *new(TSource), value)
+	if !ok && (value != nil || reflect.TypeFor[TSource]().Kind() != reflect.Interface) {
+		err := fmt.Errorf("invalid source type: expected %v, got %T", reflect.TypeFor[TSource](), value)
 		return NewFieldMappingResult(
 			fm.To(),
 			NewTypedValue(nil),
 		), err
 	}
+	if fm.when != nil && !fm.when(castedValue) {
+		return fm.bypass(castedValue)
+	}
+	if fm.using.identity {
+		// Fast path for rename-only mappings: assign the value directly without running the chain.
+		return fm.result(castedValue, FieldCopied)
+	}
+
 	mapped, err := fm.mapTyped(castedValue)
 	if err != nil {
 		return NewFieldMappingResult(
@@ -86,8 +219,53 @@ func (fm FieldMapping[TSource, TDest]) Map(value any) (FieldMappingResult, error
 		), err
 
 	}
-	return NewFieldMappingResult(
-		fm.To(),
-		NewTypedValue(mapped),
-	), nil
+	return fm.result(mapped, fm.status())
+}
+
+// bypass handles a value rejected by the When predicate: it is assigned unchanged when TSource
+// fits TDest, and the field is skipped otherwise.
+func (fm FieldMapping[TSource, TDest]) bypass(value TSource) (FieldMappingResult, error) {
+	source, target := reflect.TypeFor[TSource](), reflect.TypeFor[TDest]()
+	if source.AssignableTo(target) || (source.Kind() == target.Kind() && source.ConvertibleTo(target)) {
+		return fm.result(value, FieldCopied)
+	}
+	return NewFieldMappingResult(fm.To(), NewTypedValue(nil)),
+		fmt.Errorf("condition not met for %v to %v mapping: %w", source, target, ErrSkipField)
+}
+
+// status reports how a successful run of the chain produced its value.
+func (fm FieldMapping[TSource, TDest]) status() FieldStatus {
+	switch {
+	case len(fm.using.mappers) == 0:
+		return FieldCopied
+	case fm.validateOnly:
+		return FieldValidated
+	default:
+		return FieldConverted
+	}
+}
+
+// WithValueWrapper returns a copy of the mapping that wraps mapped values with wrap instead of
+// NewTypedValue.
+func (fm FieldMapping[TSource, TDest]) WithValueWrapper(wrap ValueWrapper) FieldMapping[TSource, TDest] {
+	fm.wrap = wrap
+	return fm
+}
+
+func (fm FieldMapping[TSource, TDest]) result(value any, status FieldStatus) (FieldMappingResult, error) {
+	wrapped := NewTypedValue(value)
+	if fm.wrap != nil {
+		wrapped = fm.wrap(value, fm.to.Type())
+		if wrapped.Type() != reflect.TypeOf(value) && wrapped.Type() != fm.to.Type() {
+			return NewFieldMappingResult(fm.To(), NewTypedValue(nil)),
+				fmt.Errorf("value wrapper returned type %v for a %T value targeting %v", wrapped.Type(), value, fm.to.Type())
+		}
+	}
+
+	result := NewFieldMappingResult(fm.To(), wrapped)
+	result.status = status
+	if status != FieldCopied {
+		result.steps = fm.using.stepNames()
+	}
+	return result, nil
 }
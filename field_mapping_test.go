@@ -2,6 +2,7 @@ package gomorph_test
 
 import (
 	"errors"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -223,3 +224,74 @@ func TestFieldMapping_MultipleMappers_RealTransformations(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldMapping_ValueWrapper(t *testing.T) {
+	provenance := func(value any, declared reflect.Type) gomorph.TypedValue {
+		return gomorph.NewTypedValue(value).WithMeta("source", "crm")
+	}
+
+	mapping := gomorph.From[string, string]("country").
+		To("normalized_country").
+		ConvertWith(&UppercaseMapper{}).
+		SkipValidation().
+		WrapWith(provenance).
+		Build()
+
+	result, err := mapping.Map("us")
+	assert.NoError(t, err)
+	assert.Equal(t, "US", result.MappedValue().Value())
+
+	source, ok := result.MappedValue().Meta("source")
+	assert.True(t, ok)
+	assert.Equal(t, "crm", source)
+
+	_, ok = gomorph.NewTypedValue("plain").Meta("source")
+	assert.False(t, ok, "default wrapping carries no metadata")
+}
+
+func TestFieldMapping_ValueWrapperWithNilValue(t *testing.T) {
+	mapping := gomorph.NewFieldMapping(
+		gomorph.NewField[any]("payload"),
+		gomorph.NewField[any]("target"),
+		gomorph.NewChainedMapper[any, any](),
+	).WithValueWrapper(func(value any, declared reflect.Type) gomorph.TypedValue {
+		return gomorph.NewTypedValueOf(value, declared)
+	})
+
+	result, err := mapping.Map(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result.MappedValue().Value())
+	assert.Nil(t, result.MappedValue().Type())
+}
+
+func TestFieldMapping_ValueWrapperMustKeepType(t *testing.T) {
+	mapping := gomorph.NewFieldMapping(
+		sourceField,
+		targetStringField,
+		gomorph.NewChainedMapper[string, string](&TrimMapper{}),
+	).WithValueWrapper(func(value any, declared reflect.Type) gomorph.TypedValue {
+		return gomorph.NewTypedValue(len(value.(string)))
+	})
+
+	_, err := mapping.Map(" x ")
+	assert.EqualError(t, err, "value wrapper returned type int for a string value targeting string")
+}
+
+func TestFieldMappingResult_RawAndTryUnwrapAs(t *testing.T) {
+	result := gomorph.NewFieldMappingResult(targetStringField, gomorph.NewTypedValue("hello"))
+
+	assert.Equal(t, "hello", result.Raw())
+
+	s, ok := gomorph.TryUnwrapAs[string](result)
+	assert.True(t, ok)
+	assert.Equal(t, "hello", s)
+
+	n, ok := gomorph.TryUnwrapAs[int](result)
+	assert.False(t, ok, "mismatched types do not panic")
+	assert.Zero(t, n)
+
+	empty := gomorph.NewFieldMappingResult(targetStringField, gomorph.NewTypedValue(nil))
+	assert.Nil(t, empty.Raw())
+	_, ok = gomorph.TryUnwrapAs[string](empty)
+	assert.False(t, ok)
+}
@@ -0,0 +1,53 @@
+package gomorph
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/crc32"
+)
+
+// HashMapper fingerprints its input (any -> string), returning the hex encoded digest computed by
+// the hash returned from hashFn. The input is serialized with encode, or with fmt's %#v verb when
+// encode is nil.
+//
+// Hashes are only reproducible when the serialization is deterministic. The default encoding
+// prints struct fields in declaration order and map keys in sorted order, but it prints pointer
+// addresses rather than the values pointed to, and a change to a struct's definition changes its
+// hash. Supply an explicit encode function (e.g. json.Marshal over a stable schema) for
+// fingerprints that must survive code changes or include pointed-to data.
+func HashMapper(hashFn func() hash.Hash, encode func(any) ([]byte, error)) TypedMapper {
+	return hashMapper("HashMapper", hashFn, encode)
+}
+
+// hashMapper implements HashMapper and its presets, naming the step after the calling constructor.
+func hashMapper(name string, hashFn func() hash.Hash, encode func(any) ([]byte, error)) TypedMapper {
+	if encode == nil {
+		encode = func(v any) ([]byte, error) {
+			return []byte(fmt.Sprintf("%#v", v)), nil
+		}
+	}
+
+	return namedFunc[any, string](name, func(value any) (string, error) {
+		data, err := encode(value)
+		if err != nil {
+			return "", fmt.Errorf("encode %T for hashing: %w", value, err)
+		}
+
+		h := hashFn()
+		h.Write(data)
+		return hex.EncodeToString(h.Sum(nil)), nil
+	})
+}
+
+// SHA256Hash is a HashMapper producing SHA-256 digests with the default encoding.
+func SHA256Hash() TypedMapper {
+	return hashMapper("SHA256Hash", sha256.New, nil)
+}
+
+// CRC32Hash is a HashMapper producing IEEE CRC-32 checksums with the default encoding. It is
+// fast but not collision resistant; use it for change detection, not for security.
+func CRC32Hash() TypedMapper {
+	return hashMapper("CRC32Hash", func() hash.Hash { return crc32.NewIEEE() }, nil)
+}
@@ -0,0 +1,46 @@
+package gomorph_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashMapper(t *testing.T) {
+	sha := gomorph.SHA256Hash()
+
+	first, err := sha.From(map[string]any{"b": 2, "a": 1})
+	require.NoError(t, err)
+	second, err := sha.From(map[string]any{"a": 1, "b": 2})
+	require.NoError(t, err)
+	assert.Equal(t, first, second, "map key order does not affect the hash")
+	assert.Len(t, first, 64)
+
+	str, err := sha.From("1")
+	require.NoError(t, err)
+	num, err := sha.From(1)
+	require.NoError(t, err)
+	assert.NotEqual(t, str, num, "the default encoding distinguishes types")
+
+	crc, err := gomorph.CRC32Hash().From(Input{InputString: "a", InputInt: 1})
+	require.NoError(t, err)
+	assert.Len(t, crc, 8)
+}
+
+func TestHashMapper_CustomEncoder(t *testing.T) {
+	jsonSHA := gomorph.HashMapper(sha256.New, json.Marshal)
+
+	got, err := jsonSHA.From("abc")
+	require.NoError(t, err)
+	// sha256 of the JSON string "\"abc\""
+	assert.Equal(t, "6cc43f858fbb763301637b5af970e2a46b46f461f27e5a0f41e009c59b827b25", got)
+
+	failing := gomorph.HashMapper(sha256.New, func(any) ([]byte, error) { return nil, errors.New("boom") })
+	_, err = failing.From(1)
+	assert.EqualError(t, err, "encode int for hashing: boom")
+}
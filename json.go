@@ -0,0 +1,65 @@
+package gomorph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSONSlice decodes a JSON array held in a string or []byte into a []T
+// (any -> []T), e.g. "[1,2,3]" into []int{1, 2, 3}. Element type errors are surfaced from the
+// decoder. The JSON literal null decodes to a nil slice and "[]" to an empty, non-nil slice;
+// blank input is an error.
+func UnmarshalJSONSlice[T any]() TypedMapper {
+	return namedFunc[any, []T]("UnmarshalJSONSlice", func(value any) ([]T, error) {
+		data, err := jsonBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		trimmed := bytes.TrimSpace(data)
+		if bytes.Equal(trimmed, []byte("null")) {
+			return nil, nil
+		}
+
+		result := []T{}
+		if err := json.Unmarshal(trimmed, &result); err != nil {
+			return nil, fmt.Errorf("unmarshal JSON array into %T: %w", result, err)
+		}
+		return result, nil
+	})
+}
+
+func jsonBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("expected string or []byte, got %T", value)
+	}
+}
+
+// ValidJSON validates that a string holds well-formed JSON (string -> string) without decoding
+// it, which is cheaper than unmarshalling when the raw JSON is stored as-is. Invalid input fails
+// with CodeInvalidJSON. Use ValidJSONBytes for []byte fields.
+func ValidJSON() Validator {
+	return namedFunc[string, string]("ValidJSON", func(s string) (string, error) {
+		return s, validateJSON(s, []byte(s))
+	})
+}
+
+// ValidJSONBytes is ValidJSON for []byte values ([]byte -> []byte).
+func ValidJSONBytes() Validator {
+	return namedFunc[[]byte, []byte]("ValidJSONBytes", func(b []byte) ([]byte, error) {
+		return b, validateJSON(b, b)
+	})
+}
+
+func validateJSON(value any, data []byte) error {
+	if json.Valid(data) {
+		return nil
+	}
+	return NewValidationError("", value, "value is not valid JSON").WithCode(CodeInvalidJSON)
+}
@@ -0,0 +1,82 @@
+package gomorph_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalJSONSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected []int
+		wantErr  string
+	}{
+		{name: "string input", input: "[1,2,3]", expected: []int{1, 2, 3}},
+		{name: "bytes input", input: []byte(" [4] "), expected: []int{4}},
+		{name: "empty array", input: "[]", expected: []int{}},
+		{name: "null", input: "null", expected: nil},
+		{name: "wrong element type", input: `[1,"two"]`, wantErr: "unmarshal JSON array into []int"},
+		{name: "not an array", input: `{"a":1}`, wantErr: "cannot unmarshal object"},
+		{name: "blank", input: "", wantErr: "unexpected end of JSON input"},
+		{name: "unsupported input", input: 12, wantErr: "expected string or []byte, got int"},
+	}
+
+	mapper := gomorph.UnmarshalJSONSlice[int]()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapper.From(tt.input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestUnmarshalJSONSlice_SurfacesTypeErrors(t *testing.T) {
+	_, err := gomorph.UnmarshalJSONSlice[int]().From(`[1,"two"]`)
+
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+}
+
+func TestValidJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{name: "object", input: `{"a": [1, 2, {"b": null}]}`, valid: true},
+		{name: "scalar", input: `"text"`, valid: true},
+		{name: "whitespace around", input: " 42 \n", valid: true},
+		{name: "trailing comma", input: `{"a": 1,}`},
+		{name: "unquoted key", input: `{a: 1}`},
+		{name: "two values", input: `1 2`},
+		{name: "empty", input: ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomorph.ValidJSON().From(tt.input)
+			gotBytes, bytesErr := gomorph.ValidJSONBytes().From([]byte(tt.input))
+			if !tt.valid {
+				assert.EqualError(t, err, "validation failed: value is not valid JSON")
+				var validationErr *gomorph.ValidationError
+				require.ErrorAs(t, bytesErr, &validationErr)
+				assert.Equal(t, gomorph.CodeInvalidJSON, validationErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, bytesErr)
+			assert.Equal(t, tt.input, got)
+			assert.Equal(t, []byte(tt.input), gotBytes)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Lens focuses on one value of type T inside a struct S through plain accessor functions, so a
+// field can be read and written without reflection and renames are caught by the compiler.
+type Lens[S, T any] struct {
+	name string
+	get  func(S) T
+	set  func(*S, T)
+}
+
+// NewLens builds a Lens from a getter and a setter. name identifies the focused value in error
+// messages and Describe output; it is usually the field name.
+//
+// Example:
+//
+//	email := gomorph.NewLens("Email",
+//	    func(u User) string { return u.Email },
+//	    func(u *User, v string) { u.Email = v },
+//	)
+func NewLens[S, T any](name string, get func(S) T, set func(*S, T)) Lens[S, T] {
+	if get == nil || set == nil {
+		panic(fmt.Sprintf("NewLens: %q needs both a getter and a setter", name))
+	}
+	return Lens[S, T]{name: name, get: get, set: set}
+}
+
+func (l Lens[S, T]) Name() string {
+	return l.name
+}
+
+func (l Lens[S, T]) Get(s S) T {
+	return l.get(s)
+}
+
+func (l Lens[S, T]) Set(s *S, value T) {
+	l.set(s, value)
+}
+
+var _ AssigningFieldMapper = LensFieldMapping[struct{}, struct{}, int, int]{}
+
+// LensFieldMapping is a FieldMapper that reads its input through a Lens on the source struct S
+// and writes its result through a Lens on the destination struct D, converting A to B with a
+// ChainedMapper in between. Neither side uses reflection, and it can be mixed freely with
+// name-based mappings in the same StructMapper. Create one with NewLensFieldMapping.
+type LensFieldMapping[S, D, A, B any] struct {
+	from  Lens[S, A]
+	to    Lens[D, B]
+	using *ChainedMapper[A, B]
+}
+
+// NewLensFieldMapping maps the value focused by from onto the value focused by to. S and D must be
+// the source and destination types of the StructMapper the mapping is used with; a source given
+// as *S is also accepted. It panics if using is nil; pass an empty chain to copy the value.
+//
+// Example:
+//
+//	gomorph.NewLensFieldMapping(rowEmail, userEmail, gomorph.NewChainedMapper[string, string]())
+func NewLensFieldMapping[S, D, A, B any](from Lens[S, A], to Lens[D, B], using *ChainedMapper[A, B]) LensFieldMapping[S, D, A, B] {
+	if using == nil {
+		panic(fmt.Sprintf("NewLensFieldMapping: %q -> %q needs a chain, got nil", from.name, to.name))
+	}
+	return LensFieldMapping[S, D, A, B]{from: from, to: to, using: using}
+}
+
+func (m LensFieldMapping[S, D, A, B]) From() Field {
+	return fieldInfo{name: m.from.name, typ: reflect.TypeFor[A]()}
+}
+
+func (m LensFieldMapping[S, D, A, B]) To() Field {
+	return fieldInfo{name: m.to.name, typ: reflect.TypeFor[B]()}
+}
+
+// SourceAccessor reads the input through the source lens.
+func (m LensFieldMapping[S, D, A, B]) SourceAccessor() SourceAccessor {
+	return func(source any) (any, error) {
+		switch s := source.(type) {
+		case S:
+			return m.from.get(s), nil
+		case *S:
+			if s != nil {
+				return m.from.get(*s), nil
+			}
+		}
+		return nil, fmt.Errorf("lens %q expects a %v source, got %T", m.from.name, reflect.TypeFor[S](), source)
+	}
+}
+
+func (m LensFieldMapping[S, D, A, B]) Map(value any) (FieldMappingResult, error) {
+	input, ok := value.(A)
+	if !ok && value != nil {
+		return NewFieldMappingResult(m.To(), NewTypedValue(nil)),
+			fmt.Errorf("invalid source type: expected %v, got %T", reflect.TypeFor[A](), value)
+	}
+	mapped, err := m.using.Map(input)
+	if err != nil {
+		return NewFieldMappingResult(m.To(), NewTypedValue(nil)), err
+	}
+
+	result := NewFieldMappingResult(m.To(), NewTypedValue(mapped))
+	result.status = FieldCopied
+	if m.using.Len() > 0 {
+		result.status = FieldConverted
+		result.steps = m.using.stepNames()
+	}
+	return result, nil
+}
+
+// Assign writes value through the destination lens.
+func (m LensFieldMapping[S, D, A, B]) Assign(dest any, value any) error {
+	d, ok := dest.(*D)
+	if !ok {
+		return fmt.Errorf("lens %q expects a %v destination, got %T", m.to.name, reflect.TypeFor[*D](), dest)
+	}
+	v, ok := value.(B)
+	if !ok && value != nil {
+		return fmt.Errorf("lens %q expects a %v value, got %T", m.to.name, reflect.TypeFor[B](), value)
+	}
+	m.to.set(d, v)
+	return nil
+}
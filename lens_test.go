@@ -0,0 +1,62 @@
+package gomorph_test
+
+import (
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	inputStringLens = gomorph.NewLens("InputString",
+		func(i Input) string { return i.InputString },
+		func(i *Input, v string) { i.InputString = v },
+	)
+	mappedIntLens = gomorph.NewLens("MappedInputInt",
+		func(o Output) int { return o.MappedInputInt },
+		func(o *Output, v int) { o.MappedInputInt = v },
+	)
+)
+
+func TestLens(t *testing.T) {
+	input := Input{InputString: "a"}
+	assert.Equal(t, "a", inputStringLens.Get(input))
+
+	inputStringLens.Set(&input, "b")
+	assert.Equal(t, "b", input.InputString)
+	assert.Equal(t, "InputString", inputStringLens.Name())
+
+	assert.Panics(t, func() { gomorph.NewLens[Input, string]("x", nil, nil) })
+}
+
+func TestLensFieldMapping(t *testing.T) {
+	length := gomorph.NewLensFieldMapping(inputStringLens, mappedIntLens,
+		gomorph.NewChainedMapper[string, int](StringToIntMapper{}, IntDoubler{}))
+
+	mapper := gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{
+		length,
+		gomorph.From[string, string]("InputString").To("MappedInputString").SkipConversion().SkipValidation().Build(),
+	})
+
+	result, reports, err := mapper.FromVerbose(Input{InputString: "abc"})
+	require.NoError(t, err)
+	assert.Equal(t, Output{MappedInputString: "abc", MappedInputInt: 6}, result)
+	assert.Equal(t, gomorph.FieldConverted, reports[0].Status)
+	assert.Equal(t, "MappedInputInt", reports[0].To)
+
+	pointers := gomorph.NewStructMapper[*Input, Output]([]gomorph.FieldMapper{length})
+	result, err = pointers.From(&Input{InputString: "ab"})
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.MappedInputInt, "pointer sources are read through the lens too")
+
+	wrongSource := gomorph.NewStructMapper[Output, Output]([]gomorph.FieldMapper{length})
+	_, err = wrongSource.From(Output{})
+	assert.EqualError(t, err, `input error [InputString]: lens "InputString" expects a gomorph_test.Input source, got gomorph_test.Output`)
+}
+
+func TestNewLensFieldMapping_NilChain(t *testing.T) {
+	assert.PanicsWithValue(t, `NewLensFieldMapping: "InputString" -> "MappedInputInt" needs a chain, got nil`, func() {
+		gomorph.NewLensFieldMapping[Input, Output, string, int](inputStringLens, mappedIntLens, nil)
+	})
+}
@@ -1,8 +1,12 @@
 package gomorph
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 )
 
 type Record = map[string]any
@@ -49,6 +53,20 @@ type TypedMapper interface {
 	TypeInfo
 }
 
+// ContextTypedMapper is implemented by TypedMappers whose work can be cancelled, such as those
+// calling external services. ChainedMapper.MapContext passes its context to FromContext, and Map
+// passes context.Background(); callers outside a chain use From.
+type ContextTypedMapper interface {
+	TypedMapper
+	FromContext(ctx context.Context, source any) (any, error)
+}
+
+// Named is implemented by TypedMappers that have a human-readable name. Chain error messages and
+// FieldReport steps use it, falling back to the mapper's type name.
+type Named interface {
+	Name() string
+}
+
 // TypeMap is a zero-value helper type used to represent the source and target types
 // of a TypedMapper at runtime. It provides the type information needed for dynamic
 // composition of mappers via reflection, without implementing any actual transformation logic.
@@ -96,6 +114,7 @@ func EnsureSlice[T any]() {
 // the element mapper must implement the TypedMapper interface.
 type SliceMapper[TSource Slice[T], TDest Slice[D], T, D any] struct {
 	elementMapper TypedMapper
+	indexed       bool
 }
 
 // This craziness lets us restrict to a slice of whatever type but its verbose and annoying
@@ -115,19 +134,32 @@ func (stm *SliceMapper[TSource, TDest, T, D]) SourceType() reflect.Type {
 }
 
 func (stm *SliceMapper[TSource, TDest, T, D]) TargetType() reflect.Type {
-	var zero T
+	var zero TDest
 	return reflect.TypeOf(zero)
 }
 
+// From maps each element of source. Besides TSource it accepts any slice whose element type is T,
+// so a named slice type such as "type Tags []string" can be passed where []string is expected,
+// and vice versa. A nil source slice maps to a nil TDest and an empty one to an empty, non-nil
+// TDest, so "no value" and "no elements" stay distinguishable. Elements for which the element
+// mapper returns ErrSkipElement are left out.
 func (stm *SliceMapper[TSource, TDest, T, D]) From(source any) (any, error) {
-	castedSource, ok := source.(TSource)
+	elements, ok := sliceElements[TSource, T](source)
 	if !ok {
 		return nil, fmt.Errorf("invalid source type: expected %T, got %T", *new(TSource), source)
 	}
 
-	var result TDest
-	for _, element := range castedSource {
-		transformed, err := stm.elementMapper.From(element)
+	if elements == nil {
+		var none TDest
+		return none, nil
+	}
+
+	result := make(TDest, 0, len(elements))
+	for i, element := range elements {
+		transformed, err := stm.mapElement(i, element)
+		if errors.Is(err, ErrSkipElement) {
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -137,6 +169,49 @@ func (stm *SliceMapper[TSource, TDest, T, D]) From(source any) (any, error) {
 	return result, nil
 }
 
+func (stm *SliceMapper[TSource, TDest, T, D]) mapElement(index int, element T) (any, error) {
+	if stm.indexed {
+		if indexed, ok := stm.elementMapper.(IndexedTypedMapper); ok {
+			return indexed.FromIndexed(index, element)
+		}
+	}
+	return stm.elementMapper.From(element)
+}
+
+// IndexedTypedMapper is implemented by element mappers that need the position of the element they
+// map, such as for assigning sequence numbers. IndexedSliceMapper calls FromIndexed with the
+// element's index in the source slice.
+type IndexedTypedMapper interface {
+	TypedMapper
+	FromIndexed(index int, source any) (any, error)
+}
+
+// IndexedSliceMapper is a SliceMapper that passes each element's source index to element mappers
+// implementing IndexedTypedMapper. Element mappers without FromIndexed are called with From.
+type IndexedSliceMapper[TSource Slice[T], TDest Slice[D], T, D any] struct {
+	*SliceMapper[TSource, TDest, T, D]
+}
+
+func NewIndexedSliceMapper[TSource Slice[T], TDest Slice[D], T, D any](elementMapper TypedMapper) *IndexedSliceMapper[TSource, TDest, T, D] {
+	mapper := NewSliceMapper[TSource, TDest](elementMapper)
+	mapper.indexed = true
+	return &IndexedSliceMapper[TSource, TDest, T, D]{SliceMapper: mapper}
+}
+
+// sliceElements returns source as a []T when it is a TSource or any other slice type with element
+// type T.
+func sliceElements[TSource Slice[T], T any](source any) ([]T, bool) {
+	if typed, ok := source.(TSource); ok {
+		return []T(typed), true
+	}
+	val := reflect.ValueOf(source)
+	target := reflect.TypeFor[[]T]()
+	if val.Kind() != reflect.Slice || val.Type().Elem() != target.Elem() {
+		return nil, false
+	}
+	return val.Convert(target).Interface().([]T), true
+}
+
 // ChainedMapper composes multiple TypedMapper instances into a single transformation pipeline,
 // where the output of one mapper is passed as the input to the next.
 //
@@ -156,52 +231,189 @@ func (stm *SliceMapper[TSource, TDest, T, D]) From(source any) (any, error) {
 //
 //	chained := mapper.NewChainedMapper[string, int](mappers)
 //	result, err := chained.From("hello") // result is 10 if len("hello") == 5
+//
+// A ChainedMapper is immutable once constructed: Map keeps all of its state on the stack and
+// WithCoercion returns a copy. A single instance may therefore be shared by many FieldMappings
+// and used from any number of goroutines, provided the mappers it holds are themselves safe for
+// concurrent use. All mappers in this package are. The exception is Append and Prepend, which
+// modify the chain in place, so finish extending a chain before sharing it.
 type ChainedMapper[TSource, TDest any] struct {
-	mappers []TypedMapper
+	mappers  []TypedMapper
+	identity bool
+	coerce   bool
 }
 
-// NewChainedMapper creates a new composition chain of mappers.
+// NewChainedMapper creates a new composition chain of mappers. It panics if the mappers do not
+// line up; use NewChainedMapperSafe when the chain comes from configuration.
 func NewChainedMapper[TSource, TDest any](mappers ...TypedMapper) *ChainedMapper[TSource, TDest] {
-	if len(mappers) == 0 {
-		return &ChainedMapper[TSource, TDest]{mappers: mappers}
+	chain, err := NewChainedMapperSafe[TSource, TDest](mappers...)
+	if err != nil {
+		panic(err.Error())
 	}
+	return chain
+}
 
+// NewChainedMapperSafe is NewChainedMapper for chains assembled at runtime: instead of panicking
+// it returns an error naming the step that does not line up and the expected and actual types.
+func NewChainedMapperSafe[TSource, TDest any](mappers ...TypedMapper) (*ChainedMapper[TSource, TDest], error) {
 	var sourceType TSource
-	expectedSourceType := reflect.TypeOf(sourceType)
-
-	if mappers[0].SourceType() != expectedSourceType {
-		panic(fmt.Sprintf("first mapper must accept %T, got %v", sourceType, mappers[0].SourceType()))
+	var destType TDest
+	if err := validateChain(reflect.TypeOf(sourceType), reflect.TypeOf(destType), mappers); err != nil {
+		return nil, err
 	}
 
+	return &ChainedMapper[TSource, TDest]{mappers: mappers, identity: isIdentityChain[TSource, TDest](mappers)}, nil
+}
+
+// Append adds m to the end of the chain. The previous last step must produce what m accepts, and
+// m must produce TDest; otherwise the chain is left unchanged and an error is returned.
+func (c *ChainedMapper[TSource, TDest]) Append(m TypedMapper) error {
+	return c.replaceMappers(append(slices.Clone(c.mappers), m))
+}
+
+// Prepend adds m to the start of the chain. m must accept TSource and produce what the previous
+// first step accepts; otherwise the chain is left unchanged and an error is returned.
+func (c *ChainedMapper[TSource, TDest]) Prepend(m TypedMapper) error {
+	return c.replaceMappers(append([]TypedMapper{m}, c.mappers...))
+}
+
+func (c *ChainedMapper[TSource, TDest]) replaceMappers(mappers []TypedMapper) error {
+	var sourceType TSource
 	var destType TDest
-	expectedDestType := reflect.TypeOf(destType)
-	if mappers[len(mappers)-1].TargetType() != expectedDestType {
-		panic(fmt.Sprintf("last mapper must produce %T, got %v", destType, mappers[len(mappers)-1].TargetType()))
+	if err := validateChain(reflect.TypeOf(sourceType), reflect.TypeOf(destType), mappers); err != nil {
+		return err
 	}
+	c.mappers = mappers
+	c.identity = isIdentityChain[TSource, TDest](mappers)
+	return nil
+}
 
-	lenMappers := len(mappers)
-	for i, m := range mappers {
-		if i+1 >= lenMappers {
-			continue
+// Steps returns a copy of the mappers in the chain, in order.
+func (c *ChainedMapper[TSource, TDest]) Steps() []TypedMapper {
+	return slices.Clone(c.mappers)
+}
+
+// Len returns the number of steps in the chain.
+func (c *ChainedMapper[TSource, TDest]) Len() int {
+	return len(c.mappers)
+}
+
+// DescribeChain returns "SourceType -> TargetType" for each step, in order, for logging the
+// transformation path. Steps that accept or produce any value show that side as "any".
+func (c *ChainedMapper[TSource, TDest]) DescribeChain() []string {
+	described := make([]string, len(c.mappers))
+	for i, m := range c.mappers {
+		described[i] = describeType(m.SourceType()) + " -> " + describeType(m.TargetType())
+	}
+	return described
+}
+
+// describeType names t for DescribeChain, reporting the nil type of interface-typed steps as "any".
+func describeType(t reflect.Type) string {
+	if t == nil {
+		return "any"
+	}
+	return t.String()
+}
+
+// stepNames returns the names of the steps in the chain, in order.
+func (c *ChainedMapper[TSource, TDest]) stepNames() []string {
+	names := make([]string, len(c.mappers))
+	for i, m := range c.mappers {
+		names[i] = stepName(m)
+	}
+	return names
+}
+
+// validateChain checks that mappers accept source, produce dest and line up with each other.
+// An empty chain is always valid.
+func validateChain(source, dest reflect.Type, mappers []TypedMapper) error {
+	if len(mappers) == 0 {
+		return nil
+	}
+
+	if mappers[0].SourceType() != source {
+		return fmt.Errorf("first mapper must accept %v, got %v", source, mappers[0].SourceType())
+	}
+	if last := mappers[len(mappers)-1]; last.TargetType() != dest {
+		return fmt.Errorf("last mapper must produce %v, got %v", dest, last.TargetType())
+	}
+	return validateAdjacent(mappers)
+}
+
+// validateAdjacent checks that the output type of every mapper is the input type of the next.
+func validateAdjacent(mappers []TypedMapper) error {
+	for i := 0; i+1 < len(mappers); i++ {
+		if produced, accepted := mappers[i].TargetType(), mappers[i+1].SourceType(); produced != accepted {
+			return fmt.Errorf("type mismatch between mapper %d output and mapper %d input: %v is not %v", i, i+1, produced, accepted)
 		}
+	}
+	return nil
+}
 
-		if m.TargetType() != mappers[i+1].SourceType() {
-			panic(fmt.Sprintf("type mismatch between mapper %d output and mapper %d input", i, i+1))
+// isIdentityChain reports whether a chain is a pure pass-through: the source and destination
+// types are the same and every step is an IdentityMapper. Such chains, e.g. rename-only field
+// mappings, can copy their input directly instead of running each step.
+func isIdentityChain[TSource, TDest any](mappers []TypedMapper) bool {
+	if reflect.TypeFor[TSource]() != reflect.TypeFor[TDest]() {
+		return false
+	}
+	for _, m := range mappers {
+		if _, ok := m.(identityMapper); !ok {
+			return false
 		}
 	}
+	return true
+}
+
+// CanAccept reports whether value could be fed into the chain, without executing any step. The
+// value's dynamic type must be assignable to the input type of the first step (or to TSource
+// for an empty chain); nil is accepted only when that type is nilable.
+func (c *ChainedMapper[TSource, TDest]) CanAccept(value any) error {
+	expected := reflect.TypeFor[TSource]()
+	if len(c.mappers) > 0 && c.mappers[0].SourceType() != nil {
+		expected = c.mappers[0].SourceType()
+	}
 
-	return &ChainedMapper[TSource, TDest]{mappers: mappers}
+	if value == nil {
+		if isNilableKind(expected.Kind()) {
+			return nil
+		}
+		return fmt.Errorf("chain cannot accept nil: expected %v", expected)
+	}
+
+	if actual := reflect.TypeOf(value); !actual.AssignableTo(expected) {
+		return fmt.Errorf("chain cannot accept %v: expected %v", actual, expected)
+	}
+	return nil
 }
 
+// Map runs input through every step of the chain. An empty chain is an identity when TSource and
+// TDest are the same type. Otherwise it fails with an "empty mapper chain" error, even when the
+// value happens to fit TDest, unless WithCoercion can convert it.
 func (c *ChainedMapper[TSource, TDest]) Map(input TSource) (TDest, error) {
-	var err error
-	var current any = input
-	for i, m := range c.mappers {
-		current, err = m.From(current)
-		if err != nil {
-			var zero TDest
-			return zero, fmt.Errorf("mapper chain failed at step %d: %w", i+1, err)
-		}
+	return c.mapWith(context.Background(), input)
+}
+
+// MapContext is like Map but checks ctx before each step, stopping the chain with an error that
+// wraps ctx.Err() once ctx is done. Steps implementing ContextTypedMapper receive ctx through
+// FromContext; all others are called with From.
+func (c *ChainedMapper[TSource, TDest]) MapContext(ctx context.Context, input TSource) (TDest, error) {
+	return c.mapWith(ctx, input)
+}
+
+func (c *ChainedMapper[TSource, TDest]) mapWith(ctx context.Context, input TSource) (TDest, error) {
+	if len(c.mappers) == 0 {
+		return c.mapEmpty(input)
+	}
+
+	current, err := runChainContext(ctx, c.mappers, input, c.coerce)
+	if err != nil {
+		var zero TDest
+		return zero, err
+	}
+	if c.coerce {
+		current = coerceStep(current, reflect.TypeFor[TDest]())
 	}
 
 	result, ok := current.(TDest)
@@ -212,6 +424,73 @@ func (c *ChainedMapper[TSource, TDest]) Map(input TSource) (TDest, error) {
 	return result, nil
 }
 
+func (c *ChainedMapper[TSource, TDest]) mapEmpty(input TSource) (TDest, error) {
+	var zero TDest
+	if reflect.TypeFor[TSource]() == reflect.TypeFor[TDest]() {
+		result, _ := any(input).(TDest) // only fails for a nil interface, which passes through as zero
+		return result, nil
+	}
+	if c.coerce {
+		if result, ok := coerceStep(input, reflect.TypeFor[TDest]()).(TDest); ok {
+			return result, nil
+		}
+	}
+	return zero, fmt.Errorf("empty mapper chain cannot convert %v to %v", reflect.TypeFor[TSource](), reflect.TypeFor[TDest]())
+}
+
+// WithCoercion returns a copy of the chain that converts each intermediate value to the type the
+// next step declares before passing it on, and the final value to TDest. Only conversions
+// assignValue would accept are made: assignable values, or same-kind conversions such as a
+// defined string type to string. Values that cannot be converted are passed through unchanged so
+// the step reports its own error. Chains are strict by default.
+func (c *ChainedMapper[TSource, TDest]) WithCoercion() *ChainedMapper[TSource, TDest] {
+	coerced := *c
+	coerced.coerce = true
+	return &coerced
+}
+
+// runChain feeds input through mappers in order, returning the output of the last one. With
+// coerce set, each value is first converted to the input type the step declares.
+func runChain(mappers []TypedMapper, input any, coerce bool) (any, error) {
+	return runChainContext(context.Background(), mappers, input, coerce)
+}
+
+// runChainContext is runChain with cancellation: ctx is checked before each step and handed to
+// steps implementing ContextTypedMapper.
+func runChainContext(ctx context.Context, mappers []TypedMapper, input any, coerce bool) (any, error) {
+	var err error
+	current := input
+	for i, m := range mappers {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("mapper chain aborted before step %d: %w", i+1, err)
+		}
+		if coerce {
+			current = coerceStep(current, m.SourceType())
+		}
+		if cm, ok := m.(ContextTypedMapper); ok {
+			current, err = cm.FromContext(ctx, current)
+		} else {
+			current, err = m.From(current)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("mapper chain failed at step %d (%s): %w", i+1, stepName(m), err)
+		}
+	}
+	return current, nil
+}
+
+// coerceStep converts value to target when coerceValue allows it and returns it unchanged otherwise.
+// A nil target, as declared by interface-typed steps, accepts any value.
+func coerceStep(value any, target reflect.Type) any {
+	if target == nil || value == nil {
+		return value
+	}
+	if v, ok := coerceValue(reflect.ValueOf(value), target); ok {
+		return v.Interface()
+	}
+	return value
+}
+
 // StructMapper represents a composite field-level mapper for complex structured types.
 // It manages a set of individual FieldMapper instances, each responsible for transforming a
 // specific field from the source type to the destination type.
@@ -223,6 +502,9 @@ func (c *ChainedMapper[TSource, TDest]) Map(input TSource) (TDest, error) {
 // Consumers must provide a concrete From implementation that coordinates mapping logic
 // across fields, typically by invoking each FieldMapper in the fieldMappings map.
 //
+// TDest may be a pointer to a struct, such as *UserModel: the struct is then allocated for each
+// mapping and returned by pointer, and a nil pointer source yields a nil result.
+//
 // Example:
 //
 //	type UserMapper struct {
@@ -240,35 +522,277 @@ func (c *ChainedMapper[TSource, TDest]) Map(input TSource) (TDest, error) {
 //	})
 type StructMapper[TSource, TDest any] struct {
 	fieldMappings []FieldMapper
+	config        structConfig
 }
 
 func (b *StructMapper[TSource, TDest]) From(input TSource) (TDest, error) {
+	return b.from(input, nil)
+}
+
+// FromVerbose maps input like From and also returns a report of how each target field was
+// produced, in mapping order, for audit trails. When mapping fails the report ends with the
+// failed field.
+func (b *StructMapper[TSource, TDest]) FromVerbose(input TSource) (TDest, []FieldReport, error) {
+	report := []FieldReport{}
+	output, err := b.from(input, &report)
+	return output, report, err
+}
+
+func (b *StructMapper[TSource, TDest]) from(input TSource, report *[]FieldReport) (TDest, error) {
 	var output TDest
-	err := mapStruct(input, &output, b.fieldMappings)
+	target := any(&output)
+	if destType := reflect.TypeFor[TDest](); destType.Kind() == reflect.Ptr {
+		// A pointer destination such as *UserModel is allocated here and filled in place; a nil
+		// pointer source maps to a nil destination.
+		if isNilPointer(input) {
+			return output, nil
+		}
+		allocated := reflect.New(destType.Elem())
+		reflect.ValueOf(&output).Elem().Set(allocated)
+		target = allocated.Interface()
+	}
+
+	err := mapStruct(input, target, b.fieldMappings, b.config, report)
 	if err != nil {
 		return output, err
 	}
+
+	for _, validate := range b.config.validators {
+		if err := StructValidate(output, validate); err != nil {
+			return output, err
+		}
+	}
 	return output, nil
 }
 
-func NewStructMapper[TSource, TDest any](mappings []FieldMapper) StructMapper[TSource, TDest] {
+// FieldMappingDescription summarizes one field mapping of a StructMapper for documentation,
+// UIs and audits.
+type FieldMappingDescription struct {
+	Name     string
+	From     string
+	FromType reflect.Type
+	To       string
+	ToType   reflect.Type
+	Metadata map[string]string
+}
+
+// Describe lists the field mappings of the StructMapper in declaration order, including their
+// names and any metadata attached to them.
+func (b *StructMapper[TSource, TDest]) Describe() []FieldMappingDescription {
+	descriptions := make([]FieldMappingDescription, 0, len(b.fieldMappings))
+	for _, fieldMapper := range b.fieldMappings {
+		description := FieldMappingDescription{
+			Name:     fieldMappingName(fieldMapper),
+			From:     fieldMapper.From().Name(),
+			FromType: fieldMapper.From().Type(),
+			To:       fieldMapper.To().Name(),
+			ToType:   fieldMapper.To().Type(),
+		}
+		if labelled, ok := fieldMapper.(MetadataFieldMapper); ok {
+			description.Metadata = labelled.Metadata()
+		}
+		descriptions = append(descriptions, description)
+	}
+	return descriptions
+}
+
+// isNilPointer reports whether v is a nil pointer.
+func isNilPointer(v any) bool {
+	val := reflect.ValueOf(v)
+	return val.Kind() == reflect.Ptr && val.IsNil()
+}
+
+// fieldMappingName returns the name given with the builder's Named step, defaulting to the
+// target field name.
+func fieldMappingName(fieldMapper FieldMapper) string {
+	if named, ok := fieldMapper.(NamedFieldMapper); ok {
+		return named.Name()
+	}
+	return fieldMapper.To().Name()
+}
+
+func NewStructMapper[TSource, TDest any](mappings []FieldMapper, opts ...StructOption) StructMapper[TSource, TDest] {
+	var config structConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
 
 	return StructMapper[TSource, TDest]{
 		fieldMappings: mappings,
+		config:        config,
+	}
+}
+
+// StrictStructMapper is NewStructMapper for mappings that must cover the whole destination: it
+// returns an error naming every exported TDest field that no FieldMapper writes to, so a field
+// added to the struct but forgotten in the mapping config is caught at construction instead of
+// being silently left at its zero value. Fields promoted from an embedded struct are covered by
+// a mapping that targets them or the embedded struct itself.
+//
+// Example:
+//
+//	mapper, err := gomorph.StrictStructMapper[UserDTO, UserModel](mappings)
+func StrictStructMapper[TSource, TDest any](mappings []FieldMapper, opts ...StructOption) (StructMapper[TSource, TDest], error) {
+	destType := structBase(reflect.TypeFor[TDest]())
+	if destType.Kind() != reflect.Struct {
+		return StructMapper[TSource, TDest]{}, fmt.Errorf("StrictStructMapper: %v is not a struct", destType)
+	}
+	if missing := unmappedFields(destType, mappings); len(missing) > 0 {
+		return StructMapper[TSource, TDest]{},
+			fmt.Errorf("StrictStructMapper: no mapping writes to %s on %v", strings.Join(missing, ", "), destType)
+	}
+	return NewStructMapper[TSource, TDest](mappings, opts...), nil
+}
+
+// unmappedFields lists the exported fields of the struct type t that none of mappings targets.
+func unmappedFields(t reflect.Type, mappings []FieldMapper) []string {
+	targets := make(map[string]bool, len(mappings))
+	for _, mapping := range mappings {
+		if multi, ok := mapping.(MultiFieldMapper); ok {
+			for _, target := range multi.Targets() {
+				targets[target.Name()] = true
+			}
+			continue
+		}
+		targets[mapping.To().Name()] = true
+	}
+
+	var missing []string
+	var covered [][]int
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || hasIndexPrefix(field.Index, covered) {
+			continue
+		}
+		if targets[field.Name] {
+			if field.Anonymous {
+				covered = append(covered, field.Index)
+			}
+			continue
+		}
+		if !field.Anonymous {
+			missing = append(missing, field.Name)
+		}
+	}
+	return missing
+}
+
+// hasIndexPrefix reports whether index lies inside one of the embedded fields in prefixes.
+func hasIndexPrefix(index []int, prefixes [][]int) bool {
+	for _, prefix := range prefixes {
+		if len(prefix) < len(index) && slices.Equal(prefix, index[:len(prefix)]) {
+			return true
+		}
+	}
+	return false
+}
+
+// structConfig holds the optional behaviour of a StructMapper configured through StructOption values.
+type structConfig struct {
+	validators    []func(any) error
+	accessors     []AccessorKind
+	collectErrors bool
+}
+
+// StructOption configures optional behaviour of a StructMapper.
+type StructOption func(*structConfig)
+
+// WithStructValidation registers a function that validates the fully mapped destination value.
+// Validation runs after every field has been assigned, which makes it a good place to plug in an
+// existing struct-tag validator (e.g. go-playground/validator) without gomorph depending on it:
+//
+//	validate := validator.New()
+//	mapper := gomorph.NewStructMapper[UserDTO, User](fields,
+//	    gomorph.WithStructValidation(validate.Struct),
+//	)
+//
+// Multiple validations may be registered; they run in order and the first failure is returned
+// as a *StructValidationError.
+func WithStructValidation(validate func(any) error) StructOption {
+	return func(c *structConfig) {
+		c.validators = append(c.validators, validate)
 	}
 }
 
+// OneOfFieldsSet is a struct validation that allows at most one of the named fields to be
+// non-zero after mapping, for oneof-style API models where, say, only one of Email, Phone and
+// SlackID may be given. The error names every field that is set. A name that is not a field of
+// the destination struct is reported as an error when the mapper runs.
+//
+// Example:
+//
+//	mapper := gomorph.NewStructMapper[ContactDTO, Contact](fields,
+//	    gomorph.OneOfFieldsSet("Email", "Phone", "SlackID"),
+//	)
+func OneOfFieldsSet(fields ...string) StructOption {
+	if len(fields) < 2 {
+		panic(fmt.Sprintf("OneOfFieldsSet: need at least two fields, got %d", len(fields)))
+	}
+	return WithStructValidation(func(obj any) error {
+		val := reflect.ValueOf(obj)
+		if val.Kind() == reflect.Ptr {
+			val = val.Elem()
+		}
+
+		var set []string
+		for _, name := range fields {
+			field, err := structField(val, name, false)
+			if err != nil {
+				return err
+			}
+			if !field.IsValid() {
+				return fmt.Errorf("field %q not found on %v", name, val.Type())
+			}
+			if !field.IsZero() {
+				set = append(set, name)
+			}
+		}
+		if len(set) > 1 {
+			return fmt.Errorf("only one of %s may be set, got %s", strings.Join(fields, ", "), strings.Join(set, " and "))
+		}
+		return nil
+	})
+}
+
+// CollectFieldErrors makes the StructMapper map every field even after one has failed, and
+// report all failures together as FieldErrors, so a form or API payload with several invalid
+// fields is rejected with every problem at once. Fields that succeed are still written to the
+// returned output. A failing gating field still aborts the mapping immediately, and struct
+// validations only run when every field mapped.
+func CollectFieldErrors() StructOption {
+	return func(c *structConfig) {
+		c.collectErrors = true
+	}
+}
+
+// StructValidate runs validate against obj and wraps any failure in a *StructValidationError so
+// callers can tell post-mapping validation failures apart from field mapping errors.
+func StructValidate(obj any, validate func(any) error) error {
+	if validate == nil {
+		return nil
+	}
+	if err := validate(obj); err != nil {
+		return &StructValidationError{
+			Type: reflect.TypeOf(obj),
+			Err:  err,
+		}
+	}
+	return nil
+}
+
 func assignValue(obj any, to string, value any) error {
 	val := reflect.ValueOf(obj)
 	if val.Kind() == reflect.Ptr {
 		val = val.Elem()
 	}
 
-	field := val.FieldByName(to)
+	field, err := structField(val, to, true)
+	if err != nil {
+		return err
+	}
 	if field.IsValid() && field.CanSet() {
-		v := reflect.ValueOf(value)
-		if !v.Type().AssignableTo(field.Type()) {
-			return fmt.Errorf("type mismatch: cannot assign %v to %v", v.Type(), field.Type())
+		v, ok := coerceValue(reflect.ValueOf(value), field.Type())
+		if !ok {
+			return fmt.Errorf("type mismatch: cannot assign %v to %v", reflect.TypeOf(value), field.Type())
 		}
 		field.Set(v)
 		return nil
@@ -277,9 +801,9 @@ func assignValue(obj any, to string, value any) error {
 	method := reflect.ValueOf(obj).MethodByName(to)
 	if method.IsValid() && method.Type().NumIn() == 1 {
 		argType := method.Type().In(0)
-		v := reflect.ValueOf(value)
-		if !v.Type().AssignableTo(argType) {
-			return fmt.Errorf("cannot assign value of type %v to method %q expecting %v", v.Type(), to, argType)
+		v, ok := coerceValue(reflect.ValueOf(value), argType)
+		if !ok {
+			return fmt.Errorf("cannot assign value of type %v to method %q expecting %v", reflect.TypeOf(value), to, argType)
 		}
 		method.Call([]reflect.Value{v})
 		return nil
@@ -288,25 +812,229 @@ func assignValue(obj any, to string, value any) error {
 	return fmt.Errorf("could not assign or call method for %s", to)
 }
 
+// checkDeclaredType verifies that a mapped value fits the type declared by its target Field, so
+// converter and field drift is reported against the mapping rather than as a reflect error from
+// assignValue. Fields without a declared type, such as FieldDef[any], are not checked.
+func checkDeclaredType(mapped FieldMappingResult) error {
+	declared := mapped.TargetField().Type()
+	if declared == nil {
+		return nil
+	}
+	value := mapped.MappedValue().Value()
+	if _, ok := coerceValue(reflect.ValueOf(value), declared); !ok {
+		return fmt.Errorf("converter produced %v, but the target field is declared as %v", reflect.TypeOf(value), declared)
+	}
+	return nil
+}
+
+// coerceValue prepares v for assignment to target. Assignable values are used as-is. Otherwise a
+// conversion is only performed between types of the same kind, such as string and a defined
+// string type (type CharacterClass string) or []string and type Tags []string: such conversions
+// only change the type, never the value, so nothing can be lost. Conversions across kinds
+// (int to string, float64 to int, ...) are rejected.
+func coerceValue(v reflect.Value, target reflect.Type) (reflect.Value, bool) {
+	if !v.IsValid() {
+		// An untyped nil can only be assigned as the zero value of a nilable type.
+		if isNilableKind(target.Kind()) {
+			return reflect.Zero(target), true
+		}
+		return v, false
+	}
+	if v.Type().AssignableTo(target) {
+		return v, true
+	}
+	if v.Kind() == target.Kind() && v.Type().ConvertibleTo(target) {
+		return v.Convert(target), true
+	}
+	return v, false
+}
+
+// isNilableKind reports whether values of kind k can be nil.
+func isNilableKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Interface, reflect.Ptr, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+		return true
+	}
+	return false
+}
+
+// AccessorKind identifies one way of reading a named value from a source object.
+type AccessorKind int
+
+const (
+	// AccessField reads an exported struct field.
+	AccessField AccessorKind = iota
+	// AccessMapKey reads a key from a map with string keys.
+	AccessMapKey
+	// AccessMethod calls a zero-argument getter method returning a single value.
+	AccessMethod
+)
+
+func (k AccessorKind) String() string {
+	switch k {
+	case AccessField:
+		return "field"
+	case AccessMapKey:
+		return "map key"
+	case AccessMethod:
+		return "method"
+	default:
+		return fmt.Sprintf("AccessorKind(%d)", int(k))
+	}
+}
+
+// defaultAccessors is the lookup order used when no accessors are configured.
+var defaultAccessors = []AccessorKind{AccessField, AccessMapKey, AccessMethod}
+
+// WithAccessors sets the order in which a StructMapper tries to resolve source field names. Kinds
+// that are left out are never attempted, e.g. WithAccessors(AccessField, AccessMapKey) disables
+// getter methods entirely, while WithAccessors(AccessMethod, AccessField) prefers a method X()
+// over a field X when a type declares both. Without this option fields, map keys and then
+// methods are tried in that order.
+func WithAccessors(kinds ...AccessorKind) StructOption {
+	return func(c *structConfig) {
+		c.accessors = kinds
+	}
+}
+
+// SelectField extracts the named field, map key or zero-argument getter from its input
+// (any -> any), using the same lookup rules as StructMapper. It lets a chain pick a sub-value out
+// of a struct, for example selecting Address before running an address-specific chain.
+func SelectField(name string) TypedMapper {
+	return namedFunc[any, any]("SelectField", func(source any) (any, error) {
+		return getFieldValueByName(source, name)
+	})
+}
+
 func getFieldValueByName(obj any, name string) (any, error) {
-	val := reflect.ValueOf(obj)
+	return getFieldValueWith(obj, name, defaultAccessors)
+}
 
+func getFieldValueWith(obj any, name string, accessors []AccessorKind) (any, error) {
+	if len(accessors) == 0 {
+		accessors = defaultAccessors
+	}
+
+	for _, kind := range accessors {
+		var value any
+		var ok bool
+		switch kind {
+		case AccessField:
+			var err error
+			value, ok, err = readStructField(obj, name)
+			if err != nil {
+				return nil, err
+			}
+		case AccessMapKey:
+			value, ok = readMapKey(obj, name)
+		case AccessMethod:
+			value, ok = callGetter(obj, name)
+		}
+		if ok {
+			return value, nil
+		}
+	}
+
+	return nil, &FieldNotFoundError{Name: name, Type: reflect.TypeOf(obj)}
+}
+
+func readStructField(obj any, name string) (any, bool, error) {
+	val := reflect.ValueOf(obj)
 	if val.Kind() == reflect.Ptr {
 		val = val.Elem()
 	}
-	if val.Kind() == reflect.Struct {
-		if field := val.FieldByName(name); field.IsValid() && field.CanInterface() {
-			return field.Interface(), nil
+	if val.Kind() != reflect.Struct {
+		return nil, false, nil
+	}
+	field, err := structField(val, name, false)
+	if err != nil {
+		return nil, false, err
+	}
+	if field.IsValid() && field.CanInterface() {
+		return field.Interface(), true, nil
+	}
+	return nil, false, nil
+}
+
+// structField resolves the field called name on the struct val, including fields promoted from
+// embedded structs, and returns the zero Value when there is none. Promoted fields reached
+// through a nil embedded pointer read as their zero value; with alloc set, and val addressable,
+// the embedded struct is allocated instead so the field can be written. A name promoted from more
+// than one embedded struct at the same depth is reported as ErrAmbiguousField rather than being
+// treated as missing.
+func structField(val reflect.Value, name string, alloc bool) (reflect.Value, error) {
+	if val.Kind() != reflect.Struct {
+		return reflect.Value{}, nil
+	}
+	sf, ok := val.Type().FieldByName(name)
+	if !ok {
+		if paths := fieldPaths(val.Type(), name, "", map[reflect.Type]bool{}); len(paths) > 1 {
+			return reflect.Value{}, fmt.Errorf("%w: %q is promoted into %v from %s",
+				ErrAmbiguousField, name, val.Type(), strings.Join(paths, " and "))
 		}
+		return reflect.Value{}, nil
 	}
 
-	if val.Kind() == reflect.Map {
-		if field := val.MapIndex(reflect.ValueOf(name)); field.IsValid() {
-			return field.Interface(), nil
+	field := val
+	for i, index := range sf.Index {
+		if i > 0 && field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				if !alloc || !field.CanSet() {
+					return reflect.Zero(sf.Type), nil
+				}
+				field.Set(reflect.New(field.Type().Elem()))
+			}
+			field = field.Elem()
 		}
+		field = field.Field(index)
+	}
+	return field, nil
+}
+
+// fieldPaths lists the dotted paths, such as "BaseModel.ID", of every field called name in t and
+// the structs embedded in it. seen holds the structs on the current path, guarding against
+// embedding cycles.
+func fieldPaths(t reflect.Type, name, prefix string, seen map[reflect.Type]bool) []string {
+	if seen[t] {
+		return nil
+	}
+	seen[t] = true
+	defer delete(seen, t)
+
+	var paths []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Name == name {
+			paths = append(paths, prefix+f.Name)
+		}
+		if f.Anonymous {
+			if embedded := structBase(f.Type); embedded.Kind() == reflect.Struct {
+				paths = append(paths, fieldPaths(embedded, name, prefix+f.Name+".", seen)...)
+			}
+		}
+	}
+	return paths
+}
+
+func readMapKey(obj any, name string) (any, bool) {
+	val := reflect.ValueOf(obj)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Map || val.Type().Key().Kind() != reflect.String {
+		return nil, false
 	}
+	if field := val.MapIndex(reflect.ValueOf(name).Convert(val.Type().Key())); field.IsValid() {
+		return field.Interface(), true
+	}
+	return nil, false
+}
 
+func callGetter(obj any, name string) (any, bool) {
 	ptr := reflect.ValueOf(obj)
+	if !ptr.IsValid() {
+		return nil, false
+	}
 	if ptr.Kind() != reflect.Ptr {
 		copy := reflect.New(ptr.Type()).Elem()
 		copy.Set(ptr)
@@ -315,35 +1043,181 @@ func getFieldValueByName(obj any, name string) (any, error) {
 
 	method := ptr.MethodByName(name)
 	if method.IsValid() && method.Type().NumIn() == 0 && method.Type().NumOut() == 1 {
-		return method.Call(nil)[0].Interface(), nil
+		return method.Call(nil)[0].Interface(), true
 	}
-
-	return nil, fmt.Errorf("field or zero-arg getter %q not found on %T", name, obj)
+	return nil, false
 }
 
-func mapStruct[I any, O any](input I, output O, mappings []FieldMapper) error {
-	for _, fieldMapper := range mappings {
+func mapStruct[I any, O any](input I, output O, mappings []FieldMapper, config structConfig, report *[]FieldReport) error {
+	record := func(r FieldReport) {
+		if report != nil {
+			*report = append(*report, r)
+		}
+	}
+
+	var collected FieldErrors
+	// fail records a failed field and reports whether mapping has to stop: always, unless errors
+	// are being collected and the field is not gating.
+	fail := func(fieldMapper FieldMapper, r FieldReport) bool {
+		record(r)
+		if !config.collectErrors || isGating(fieldMapper) {
+			return true
+		}
+		collected = append(collected, r.Err)
+		return false
+	}
+
+	for _, fieldMapper := range gatingFirst(mappings) {
 		fromName := fieldMapper.From().Name()
-		toName := fieldMapper.To().Name()
+		label := func(field string) string { return fieldLabel(fieldMapper, field) }
 
-		rawValue, err := getFieldValueByName(input, fromName)
+		rawValue, err := readFieldSource(fieldMapper, input, config.accessors)
+		if value, ok := fieldDefault(fieldMapper, err); ok {
+			toName := fieldMapper.To().Name()
+			if err := assignResult(fieldMapper, output, toName, value); err != nil {
+				err = fmt.Errorf("output error [%s]: %w", label(toName), err)
+				if fail(fieldMapper, FieldReport{From: fromName, To: toName, Status: FieldFailed, Err: err}) {
+					return err
+				}
+				continue
+			}
+			record(FieldReport{From: fromName, To: toName, Status: FieldDefaulted})
+			continue
+		}
+		if errors.Is(err, ErrFieldNotFound) && isOptional(fieldMapper) {
+			record(FieldReport{From: fromName, To: fieldMapper.To().Name(), Status: FieldSkipped})
+			continue
+		}
 		if err != nil {
-			return fmt.Errorf("input error [%s]: %w", fromName, err)
+			err = fmt.Errorf("input error [%s]: %w", label(fromName), err)
+			if fail(fieldMapper, FieldReport{From: fromName, To: fieldMapper.To().Name(), Status: FieldFailed, Err: err}) {
+				return err
+			}
+			continue
 		}
 
-		mapped, err := fieldMapper.Map(rawValue)
+		results, err := mapFieldValue(fieldMapper, rawValue)
+		if errors.Is(err, ErrSkipField) {
+			record(FieldReport{From: fromName, To: fieldMapper.To().Name(), Status: FieldSkipped})
+			continue
+		}
 		if err != nil {
-			return fmt.Errorf("mapping error [%s]: %w", fromName, err)
+			err = fmt.Errorf("mapping error [%s]: %w", label(fromName), err)
+			if fail(fieldMapper, FieldReport{From: fromName, To: fieldMapper.To().Name(), Status: FieldFailed, Err: err}) {
+				return err
+			}
+			continue
 		}
 
-		err = assignValue(output, toName, mapped.MappedValue().Value())
-		if err != nil {
-			return fmt.Errorf("output error [%s]: %w", toName, err)
+		for _, mapped := range results {
+			toName := mapped.TargetField().Name()
+			if err := checkDeclaredType(mapped); err != nil {
+				err = fmt.Errorf("output error [%s]: mapping %s -> %s: %w", label(toName), fromName, toName, err)
+				if fail(fieldMapper, FieldReport{From: fromName, To: toName, Status: FieldFailed, Steps: mapped.Steps(), Err: err}) {
+					return err
+				}
+				continue
+			}
+			err = assignResult(fieldMapper, output, toName, mapped.MappedValue().Value())
+			if err != nil {
+				err = fmt.Errorf("output error [%s]: %w", label(toName), err)
+				if fail(fieldMapper, FieldReport{From: fromName, To: toName, Status: FieldFailed, Steps: mapped.Steps(), Err: err}) {
+					return err
+				}
+				continue
+			}
+			record(FieldReport{From: fromName, To: toName, Status: mapped.Status(), Steps: mapped.Steps()})
 		}
 	}
+	if len(collected) > 0 {
+		return collected
+	}
 	return nil
 }
 
+// fieldDefault returns the default of fieldMapper when reading its source failed with
+// ErrFieldNotFound and it is a DefaultingFieldMapper with a default set.
+func fieldDefault(fieldMapper FieldMapper, readErr error) (any, bool) {
+	if !errors.Is(readErr, ErrFieldNotFound) {
+		return nil, false
+	}
+	if defaulting, ok := fieldMapper.(DefaultingFieldMapper); ok {
+		return defaulting.Default()
+	}
+	return nil, false
+}
+
+// assignResult writes value to the field named to, or through the mapper itself when it is an
+// AssigningFieldMapper.
+func assignResult(fieldMapper FieldMapper, output any, to string, value any) error {
+	if assigning, ok := fieldMapper.(AssigningFieldMapper); ok {
+		return assigning.Assign(output, value)
+	}
+	return assignValue(output, to, value)
+}
+
+// fieldLabel names field in error messages, adding the mapping's name when it was given one
+// with the builder's Named step.
+func fieldLabel(fieldMapper FieldMapper, field string) string {
+	if named, ok := fieldMapper.(NamedFieldMapper); ok {
+		if name := named.Name(); name != fieldMapper.To().Name() {
+			return fmt.Sprintf("%s (%s)", field, name)
+		}
+	}
+	return field
+}
+
+// mapFieldValue runs a single FieldMapper, returning one result per target field it writes.
+func mapFieldValue(fieldMapper FieldMapper, rawValue any) ([]FieldMappingResult, error) {
+	if multi, ok := fieldMapper.(MultiFieldMapper); ok {
+		return multi.MapAll(rawValue)
+	}
+
+	mapped, err := fieldMapper.Map(rawValue)
+	if err != nil {
+		return nil, err
+	}
+	return []FieldMappingResult{mapped}, nil
+}
+
+// readFieldSource reads the input of fieldMapper from obj, through its own SourceAccessor when it
+// has one and by looking up From().Name() with accessors otherwise.
+func readFieldSource(fieldMapper FieldMapper, obj any, accessors []AccessorKind) (any, error) {
+	if a, ok := fieldMapper.(AccessorFieldMapper); ok {
+		if read := a.SourceAccessor(); read != nil {
+			return read(obj)
+		}
+	}
+	return getFieldValueWith(obj, fieldMapper.From().Name(), accessors)
+}
+
+// gatingFirst orders mappings so that gating field mappings run before all others, keeping the
+// declared order within each group.
+func gatingFirst(mappings []FieldMapper) []FieldMapper {
+	ordered := make([]FieldMapper, 0, len(mappings))
+	for _, fieldMapper := range mappings {
+		if isGating(fieldMapper) {
+			ordered = append(ordered, fieldMapper)
+		}
+	}
+	for _, fieldMapper := range mappings {
+		if !isGating(fieldMapper) {
+			ordered = append(ordered, fieldMapper)
+		}
+	}
+	return ordered
+}
+
+func isGating(fieldMapper FieldMapper) bool {
+	gating, ok := fieldMapper.(GatingFieldMapper)
+	return ok && gating.IsGating()
+}
+
+func isOptional(fieldMapper FieldMapper) bool {
+	optional, ok := fieldMapper.(OptionalFieldMapper)
+	return ok && optional.IsOptional()
+}
+
 func GetField[T any](record map[string]any, field FieldDef[T]) (T, error) {
 	val, ok := record[field.Name()]
 	if !ok {
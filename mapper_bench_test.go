@@ -0,0 +1,184 @@
+package gomorph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type WideSource struct {
+	F01 string
+	F02 string
+	F03 string
+	F04 string
+	F05 string
+	F06 string
+	F07 string
+	F08 string
+	F09 string
+	F10 string
+	F11 string
+	F12 string
+	F13 string
+	F14 string
+	F15 string
+	F16 string
+	F17 string
+	F18 string
+	F19 string
+	F20 string
+}
+
+type WideDest struct {
+	RenamedF01 string
+	RenamedF02 string
+	RenamedF03 string
+	RenamedF04 string
+	RenamedF05 string
+	RenamedF06 string
+	RenamedF07 string
+	RenamedF08 string
+	RenamedF09 string
+	RenamedF10 string
+	RenamedF11 string
+	RenamedF12 string
+	RenamedF13 string
+	RenamedF14 string
+	RenamedF15 string
+	RenamedF16 string
+	RenamedF17 string
+	RenamedF18 string
+	RenamedF19 string
+	RenamedF20 string
+}
+
+// passthroughMapper returns its input unchanged but, unlike IdentityMapper, is not recognised as
+// an identity step, so it exercises the regular chain machinery.
+type passthroughMapper struct {
+	gomorph.TypeMap[string, string]
+}
+
+func (m passthroughMapper) From(source any) (any, error) {
+	return source, nil
+}
+
+func wideMappings(step gomorph.TypedMapper) []gomorph.FieldMapper {
+	mappings := make([]gomorph.FieldMapper, 0, 20)
+	for i := 1; i <= 20; i++ {
+		name := fmt.Sprintf("F%02d", i)
+		mappings = append(mappings, gomorph.From[string, string](name).
+			To("Renamed"+name).
+			ConvertWith(step).
+			SkipValidation().
+			Build())
+	}
+	return mappings
+}
+
+func wideSource() WideSource {
+	return WideSource{
+		F01: "f01",
+		F02: "f02",
+		F03: "f03",
+		F04: "f04",
+		F05: "f05",
+		F06: "f06",
+		F07: "f07",
+		F08: "f08",
+		F09: "f09",
+		F10: "f10",
+		F11: "f11",
+		F12: "f12",
+		F13: "f13",
+		F14: "f14",
+		F15: "f15",
+		F16: "f16",
+		F17: "f17",
+		F18: "f18",
+		F19: "f19",
+		F20: "f20",
+	}
+}
+
+func BenchmarkStructMapper_IdentityFastPath(b *testing.B) {
+	mapper := gomorph.NewStructMapper[WideSource, WideDest](wideMappings(gomorph.IdentityMapper[string]{}))
+	input := wideSource()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := mapper.From(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStructMapper_ChainedPassthrough(b *testing.B) {
+	mapper := gomorph.NewStructMapper[WideSource, WideDest](wideMappings(passthroughMapper{}))
+	input := wideSource()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := mapper.From(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSliceMapper_Large maps a 10k element slice. The result reserves capacity for every
+// element up front, so appending never regrows it, which roughly halves B/op; the remaining
+// allocations come from boxing each element into an interface.
+func BenchmarkSliceMapper_Large(b *testing.B) {
+	mapper := gomorph.NewSliceMapper[[]int, []int](IntDoubler{})
+	input := make([]int, 10_000)
+	for i := range input {
+		input[i] = i
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := mapper.From(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStructMapper_MapBatch(b *testing.B) {
+	mapper := gomorph.NewStructMapper[WideSource, WideDest](wideMappings(passthroughMapper{}))
+	inputs := make([]WideSource, 1000)
+	for i := range inputs {
+		inputs[i] = wideSource()
+	}
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := mapper.MapBatch(inputs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := mapper.MapBatchParallel(inputs, 0); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestStructMapper_IdentityFastPathMatchesChain(t *testing.T) {
+	fast := gomorph.NewStructMapper[WideSource, WideDest](wideMappings(gomorph.IdentityMapper[string]{}))
+	slow := gomorph.NewStructMapper[WideSource, WideDest](wideMappings(passthroughMapper{}))
+
+	fastResult, err := fast.From(wideSource())
+	require.NoError(t, err)
+	slowResult, err := slow.From(wideSource())
+	require.NoError(t, err)
+
+	assert.Equal(t, slowResult, fastResult, "fast path and chain disagree")
+	assert.Equal(t, "f20", fastResult.RenamedF20)
+}
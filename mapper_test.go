@@ -1,7 +1,10 @@
 package gomorph_test
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/dklassen/gomorph"
@@ -216,6 +219,154 @@ func TestChainedMapperConstruction(t *testing.T) {
 	}
 }
 
+func TestNewChainedMapperSafe(t *testing.T) {
+	tests := []struct {
+		name     string
+		gomorphs []gomorph.TypedMapper
+		wantErr  string
+	}{
+		{
+			name:     "wrong first input type",
+			gomorphs: []gomorph.TypedMapper{IntDoubler{}},
+			wantErr:  "first mapper must accept string, got int",
+		},
+		{
+			name:     "wrong last output type",
+			gomorphs: []gomorph.TypedMapper{StringToIntMapper{}},
+			wantErr:  "last mapper must produce string, got int",
+		},
+		{
+			name:     "adjacent steps do not line up",
+			gomorphs: []gomorph.TypedMapper{StringToIntMapper{}, StringToIntMapper{}, gomorph.CodePointToString()},
+			wantErr:  "type mismatch between mapper 0 output and mapper 1 input: int is not string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain, err := gomorph.NewChainedMapperSafe[string, string](tt.gomorphs...)
+			assert.Nil(t, chain)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+
+	chain, err := gomorph.NewChainedMapperSafe[string, int](StringToIntMapper{}, IntDoubler{})
+	require.NoError(t, err)
+	out, err := chain.Map("abc")
+	require.NoError(t, err)
+	assert.Equal(t, 6, out)
+}
+
+func TestChainedMapper_EmptyChain(t *testing.T) {
+	same, err := gomorph.NewChainedMapper[string, string]().Map("hello")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", same)
+
+	nilAny, err := gomorph.NewChainedMapper[any, any]().Map(nil)
+	require.NoError(t, err)
+	assert.Nil(t, nilAny)
+
+	_, err = gomorph.NewChainedMapper[string, int]().Map("hello")
+	assert.EqualError(t, err, "empty mapper chain cannot convert string to int")
+
+	_, err = gomorph.NewChainedMapper[any, string]().Map("hello")
+	assert.EqualError(t, err, "empty mapper chain cannot convert interface {} to string", "a fitting value does not make the chain valid")
+
+	type Code string
+	coerced, err := gomorph.NewChainedMapper[Code, string]().WithCoercion().Map(Code("x"))
+	require.NoError(t, err)
+	assert.Equal(t, "x", coerced)
+}
+
+func TestChainedMapper_AppendPrepend(t *testing.T) {
+	chain := gomorph.NewChainedMapper[string, int](StringToIntMapper{})
+	require.NoError(t, chain.Append(IntDoubler{}))
+	require.NoError(t, chain.Prepend(gomorph.RequirePrefix("id-")))
+
+	out, err := chain.Map("id-abc")
+	require.NoError(t, err)
+	assert.Equal(t, 6, out)
+
+	assert.EqualError(t, chain.Append(gomorph.CodePointToString()), "last mapper must produce int, got string")
+	assert.EqualError(t, chain.Prepend(IntDoubler{}), "first mapper must accept string, got int")
+	assert.EqualError(t, chain.Prepend(StringToIntMapper{}), "type mismatch between mapper 0 output and mapper 1 input: int is not string")
+
+	out, err = chain.Map("id-abc")
+	require.NoError(t, err)
+	assert.Equal(t, 6, out, "failed calls leave the chain unchanged")
+
+	empty := gomorph.NewChainedMapper[string, string]()
+	require.NoError(t, empty.Append(gomorph.RequirePrefix("id-")))
+	out2, err := empty.Map("id-x")
+	require.NoError(t, err)
+	assert.Equal(t, "x", out2)
+}
+
+func TestChainedMapper_Steps(t *testing.T) {
+	chain := gomorph.NewChainedMapper[any, int](gomorph.AssertType[string](), StringToIntMapper{}, IntDoubler{})
+
+	assert.Equal(t, 3, chain.Len())
+	assert.Equal(t, []string{"any -> string", "string -> int", "int -> int"}, chain.DescribeChain())
+
+	steps := chain.Steps()
+	require.Len(t, steps, 3)
+	assert.Equal(t, IntDoubler{}, steps[2])
+	steps[0] = IntDoubler{}
+	assert.Equal(t, "any -> string", chain.DescribeChain()[0], "Steps returns a copy")
+
+	empty := gomorph.NewChainedMapper[string, string]()
+	assert.Equal(t, 0, empty.Len())
+	assert.Empty(t, empty.DescribeChain())
+
+	selecting := gomorph.NewChainedMapper[any, string](gomorph.SelectField("name"), gomorph.AssertType[string]())
+	assert.Equal(t, []string{"any -> any", "any -> string"}, selecting.DescribeChain(), "nil target types show as any")
+}
+
+// CancellingMapper records the context it was given and cancels it, so later steps see it done.
+type CancellingMapper struct {
+	gomorph.TypeMap[int, int]
+	cancel context.CancelFunc
+	seen   *context.Context
+}
+
+func (m CancellingMapper) From(source any) (any, error) {
+	return source, nil
+}
+
+func (m CancellingMapper) FromContext(ctx context.Context, source any) (any, error) {
+	*m.seen = ctx
+	m.cancel()
+	return source, nil
+}
+
+func TestChainedMapper_MapContext(t *testing.T) {
+	chain := gomorph.NewChainedMapper[string, int](StringToIntMapper{}, IntDoubler{})
+	out, err := chain.MapContext(context.Background(), "abc")
+	require.NoError(t, err)
+	assert.Equal(t, 6, out)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = chain.MapContext(cancelled, "abc")
+	assert.EqualError(t, err, "mapper chain aborted before step 1: context canceled")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var seen context.Context
+	withContext := gomorph.NewChainedMapper[string, int](
+		StringToIntMapper{},
+		CancellingMapper{cancel: cancel, seen: &seen},
+		IntDoubler{},
+	)
+	_, err = withContext.MapContext(ctx, "abc")
+	assert.Equal(t, ctx, seen, "context-aware steps receive the context")
+	assert.EqualError(t, err, "mapper chain aborted before step 3: context canceled")
+
+	out, err = withContext.Map("abc")
+	require.NoError(t, err)
+	assert.Equal(t, 6, out, "Map calls From and ignores cancellation")
+}
+
 func TestChainableMapperFails(t *testing.T) {
 	input := "hello"
 
@@ -232,7 +383,23 @@ func TestChainableMapperFails(t *testing.T) {
 
 	_, err := fieldMapping.Map(input)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "mapper chain failed at step 1: always fails error value")
+	assert.Contains(t, err.Error(), "mapper chain failed at step 1 (gomorph_test.AlwaysFailingMapper): always fails error value")
+}
+
+// NamedFailingMapper always fails and names itself for diagnostics.
+type NamedFailingMapper struct {
+	AlwaysFailingMapper
+}
+
+func (NamedFailingMapper) Name() string {
+	return "ParseCurrency"
+}
+
+func TestChainedMapper_NamedStepInError(t *testing.T) {
+	chain := gomorph.NewChainedMapper[string, string](gomorph.RequirePrefix("$"), NamedFailingMapper{})
+
+	_, err := chain.Map("$12")
+	assert.EqualError(t, err, "mapper chain failed at step 2 (ParseCurrency): always fails error value")
 }
 
 // MockTypedMapper is a mock implementation of TypedMapper for testing.
@@ -278,6 +445,132 @@ func TestSliceMapper_Success(t *testing.T) {
 	}
 }
 
+func TestSliceMapper_InChain(t *testing.T) {
+	sliceMapper := gomorph.NewSliceMapper[[]string, []int](MockTypedMapper{})
+	assert.Equal(t, reflect.TypeOf([]string{}), sliceMapper.SourceType())
+	assert.Equal(t, reflect.TypeOf([]int{}), sliceMapper.TargetType())
+
+	chain, err := gomorph.NewChainedMapperSafe[[]string, []int](gomorph.Reverse[string](), sliceMapper, gomorph.Reverse[int]())
+	require.NoError(t, err)
+
+	result, err := chain.Map([]string{"a", "bb", "ccc"})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, result)
+}
+
+func TestSliceMapper_NamedSliceTypes(t *testing.T) {
+	tests := []struct {
+		name   string
+		mapper gomorph.TypedMapper
+		input  any
+	}{
+		{name: "plain source", mapper: gomorph.NewSliceMapper[[]string, []int](MockTypedMapper{}), input: []string{"a", "bb"}},
+		{name: "named source", mapper: gomorph.NewSliceMapper[Tags, []int](MockTypedMapper{}), input: Tags{"a", "bb"}},
+		{name: "named value for plain source", mapper: gomorph.NewSliceMapper[[]string, []int](MockTypedMapper{}), input: Tags{"a", "bb"}},
+		{name: "plain value for named source", mapper: gomorph.NewSliceMapper[Tags, []int](MockTypedMapper{}), input: []string{"a", "bb"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.mapper.From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, []int{1, 2}, result)
+		})
+	}
+}
+
+func TestSliceMapper_NilAndEmpty(t *testing.T) {
+	sliceMapper := gomorph.NewSliceMapper[[]string, []int](MockTypedMapper{})
+
+	result, err := sliceMapper.From([]string(nil))
+	require.NoError(t, err)
+	assert.Nil(t, result.([]int), "a nil source maps to a nil slice")
+
+	result, err = sliceMapper.From([]string{})
+	require.NoError(t, err)
+	assert.NotNil(t, result.([]int), "an empty source maps to an empty, non-nil slice")
+	assert.Empty(t, result.([]int))
+
+	result, err = sliceMapper.From(Tags{})
+	require.NoError(t, err)
+	assert.NotNil(t, result.([]int), "named slice types keep the distinction")
+}
+
+// PositionLabeller formats elements as "index:value" when given their position.
+type PositionLabeller struct {
+	gomorph.TypeMap[string, string]
+}
+
+func (PositionLabeller) From(source any) (any, error) {
+	return source, nil
+}
+
+func (PositionLabeller) FromIndexed(index int, source any) (any, error) {
+	return fmt.Sprintf("%d:%s", index, source), nil
+}
+
+func TestIndexedSliceMapper(t *testing.T) {
+	indexed := gomorph.NewIndexedSliceMapper[[]string, []string](PositionLabeller{})
+	result, err := indexed.From([]string{"a", "b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0:a", "1:b", "2:c"}, result)
+
+	plain := gomorph.NewSliceMapper[[]string, []string](PositionLabeller{})
+	result, err = plain.From([]string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, result, "SliceMapper does not pass indexes")
+
+	fallback := gomorph.NewIndexedSliceMapper[[]string, []int](MockTypedMapper{})
+	result, err = fallback.From([]string{"a", "bb"})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, result, "element mappers without FromIndexed use From")
+
+	chain, err := gomorph.NewChainedMapperSafe[[]string, []string](indexed)
+	require.NoError(t, err)
+	out, err := chain.Map([]string{"x"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0:x"}, out)
+}
+
+// OddSkipper drops elements at odd positions and upper-cases the rest.
+type OddSkipper struct {
+	gomorph.TypeMap[string, string]
+}
+
+func (OddSkipper) From(source any) (any, error) {
+	return strings.ToUpper(source.(string)), nil
+}
+
+func (o OddSkipper) FromIndexed(index int, source any) (any, error) {
+	if index%2 == 1 {
+		return nil, fmt.Errorf("position %d: %w", index, gomorph.ErrSkipElement)
+	}
+	return o.From(source)
+}
+
+func TestSliceMapper_SkipElement(t *testing.T) {
+	skipper := gomorph.NewIndexedSliceMapper[[]string, []string](OddSkipper{})
+
+	result, err := skipper.From([]string{"a", "b", "c", "d", "e"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A", "C", "E"}, result)
+
+	skipAll := gomorph.NewSliceMapper[[]string, []string](AlwaysSkipMapper{})
+	result, err = skipAll.From([]string{"a", "b"})
+	require.NoError(t, err)
+	assert.NotNil(t, result.([]string))
+	assert.Empty(t, result.([]string), "skipping every element leaves an empty slice")
+}
+
+// AlwaysSkipMapper drops every element it is given.
+type AlwaysSkipMapper struct {
+	gomorph.TypeMap[string, string]
+}
+
+func (AlwaysSkipMapper) From(any) (any, error) {
+	return nil, gomorph.ErrSkipElement
+}
+
 func TestSliceMapper_InvalidSourceType(t *testing.T) {
 	elementMapper := MockTypedMapper{}
 	sliceMapper := gomorph.NewSliceMapper[[]string, []int](elementMapper)
@@ -347,6 +640,186 @@ func TestStructMapper_MapExportedFields(t *testing.T) {
 	})
 }
 
+func TestStructMapper_PointerDestination(t *testing.T) {
+	fieldMappings := []gomorph.FieldMapper{
+		gomorph.NewFieldMapping(
+			gomorph.NewField[string]("InputString"),
+			gomorph.NewField[string]("MappedInputString"),
+			gomorph.NewChainedMapper[string, string](),
+		),
+		gomorph.NewFieldMapping(
+			gomorph.NewField[int]("InputInt"),
+			gomorph.NewField[int]("MappedInputInt"),
+			gomorph.NewChainedMapper[int, int](),
+		),
+	}
+
+	t.Run("allocates the destination", func(t *testing.T) {
+		outputMapper := gomorph.NewStructMapper[Input, *Output](fieldMappings)
+
+		first, err := outputMapper.From(Input{InputString: "a", InputInt: 1})
+		require.NoError(t, err)
+		require.NotNil(t, first)
+		assert.Equal(t, &Output{MappedInputString: "a", MappedInputInt: 1}, first)
+
+		second, err := outputMapper.From(Input{InputString: "b", InputInt: 2})
+		require.NoError(t, err)
+		assert.NotSame(t, first, second)
+		assert.Equal(t, "a", first.MappedInputString, "each call gets its own struct")
+	})
+
+	t.Run("nil source gives a nil destination", func(t *testing.T) {
+		outputMapper := gomorph.NewStructMapper[*Input, *Output](fieldMappings)
+
+		result, err := outputMapper.From(nil)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+
+		result, err = outputMapper.From(&Input{InputString: "c", InputInt: 3})
+		require.NoError(t, err)
+		assert.Equal(t, &Output{MappedInputString: "c", MappedInputInt: 3}, result)
+	})
+}
+
+type BaseModel struct {
+	ID        int
+	CreatedBy string
+}
+
+type AuditModel struct {
+	ID int
+}
+
+type Account struct {
+	BaseModel
+	Name string
+}
+
+type AccountRef struct {
+	*BaseModel
+	Name string
+}
+
+type AmbiguousAccount struct {
+	BaseModel
+	AuditModel
+}
+
+func TestStructMapper_PromotedFields(t *testing.T) {
+	fieldMappings := []gomorph.FieldMapper{
+		gomorph.NewFieldMapping(
+			gomorph.NewField[int]("ID"),
+			gomorph.NewField[int]("ID"),
+			gomorph.NewChainedMapper[int, int](),
+		),
+		gomorph.NewFieldMapping(
+			gomorph.NewField[string]("Name"),
+			gomorph.NewField[string]("Name"),
+			gomorph.NewChainedMapper[string, string](),
+		),
+	}
+
+	t.Run("embedded struct", func(t *testing.T) {
+		mapper := gomorph.NewStructMapper[Account, Account](fieldMappings)
+		result, err := mapper.From(Account{BaseModel: BaseModel{ID: 7, CreatedBy: "ops"}, Name: "acme"})
+		require.NoError(t, err)
+		assert.Equal(t, Account{BaseModel: BaseModel{ID: 7}, Name: "acme"}, result)
+	})
+
+	t.Run("embedded pointer is allocated on write", func(t *testing.T) {
+		mapper := gomorph.NewStructMapper[Account, AccountRef](fieldMappings)
+		result, err := mapper.From(Account{BaseModel: BaseModel{ID: 7}, Name: "acme"})
+		require.NoError(t, err)
+		require.NotNil(t, result.BaseModel)
+		assert.Equal(t, 7, result.ID)
+	})
+
+	t.Run("nil embedded pointer reads as zero", func(t *testing.T) {
+		mapper := gomorph.NewStructMapper[AccountRef, Account](fieldMappings)
+		result, err := mapper.From(AccountRef{Name: "acme"})
+		require.NoError(t, err)
+		assert.Equal(t, Account{Name: "acme"}, result)
+	})
+
+	idOnly := fieldMappings[:1]
+
+	t.Run("ambiguous source field", func(t *testing.T) {
+		mapper := gomorph.NewStructMapper[AmbiguousAccount, Account](idOnly)
+		_, err := mapper.From(AmbiguousAccount{})
+		require.ErrorIs(t, err, gomorph.ErrAmbiguousField)
+		assert.EqualError(t, err, `input error [ID]: ambiguous field: "ID" is promoted into gomorph_test.AmbiguousAccount from BaseModel.ID and AuditModel.ID`)
+	})
+
+	t.Run("ambiguous destination field", func(t *testing.T) {
+		mapper := gomorph.NewStructMapper[Account, AmbiguousAccount](idOnly)
+		_, err := mapper.From(Account{})
+		require.ErrorIs(t, err, gomorph.ErrAmbiguousField)
+		assert.EqualError(t, err, `output error [ID]: ambiguous field: "ID" is promoted into gomorph_test.AmbiguousAccount from BaseModel.ID and AuditModel.ID`)
+	})
+}
+
+func TestStrictStructMapper(t *testing.T) {
+	id := gomorph.NewFieldMapping(
+		gomorph.NewField[int]("ID"),
+		gomorph.NewField[int]("ID"),
+		gomorph.NewChainedMapper[int, int](),
+	)
+	name := gomorph.NewFieldMapping(
+		gomorph.NewField[string]("Name"),
+		gomorph.NewField[string]("Name"),
+		gomorph.NewChainedMapper[string, string](),
+	)
+	base := gomorph.NewFieldMapping(
+		gomorph.NewField[BaseModel]("BaseModel"),
+		gomorph.NewField[BaseModel]("BaseModel"),
+		gomorph.NewChainedMapper[BaseModel, BaseModel](),
+	)
+
+	tests := []struct {
+		name     string
+		mappings []gomorph.FieldMapper
+		wantErr  string
+	}{
+		{
+			name:     "missing fields are listed",
+			mappings: []gomorph.FieldMapper{id},
+			wantErr:  "StrictStructMapper: no mapping writes to CreatedBy, Name on gomorph_test.Account",
+		},
+		{
+			name:     "embedded struct mapped as a whole",
+			mappings: []gomorph.FieldMapper{base, name},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gomorph.StrictStructMapper[Account, Account](tt.mappings)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	mapper, err := gomorph.StrictStructMapper[Input, *Output]([]gomorph.FieldMapper{
+		gomorph.NewFieldMapping(
+			gomorph.NewField[string]("InputString"),
+			gomorph.NewField[string]("MappedInputString"),
+			gomorph.NewChainedMapper[string, string](),
+		),
+		gomorph.NewFieldMapping(
+			gomorph.NewField[int]("InputInt"),
+			gomorph.NewField[int]("MappedInputInt"),
+			gomorph.NewChainedMapper[int, int](),
+		),
+	})
+	require.NoError(t, err)
+	result, err := mapper.From(Input{InputString: "a", InputInt: 1})
+	require.NoError(t, err)
+	assert.Equal(t, &Output{MappedInputString: "a", MappedInputInt: 1}, result)
+}
+
 type ComplexInput struct {
 	unexportedStringField string
 }
@@ -403,7 +876,7 @@ func TestStructMapper_MapAMapToStruct(t *testing.T) {
 	fields := []gomorph.FieldMapper{
 		gomorph.From[any, string]("InputString").
 			To("SomeField").
-			SkipConversion().
+			ConvertWith(gomorph.AssertType[string]()).
 			SkipValidation().
 			Build(),
 		gomorph.From[int, int]("InputInt").
@@ -421,3 +894,526 @@ func TestStructMapper_MapAMapToStruct(t *testing.T) {
 	require.Equal(t, 42, result.SomeInt)
 
 }
+
+type ValidatedOutput struct {
+	MappedInputString string `validate:"required"`
+	MappedInputInt    int
+}
+
+// requiredTagValidator is a tiny stand-in for a struct-tag validation library.
+func requiredTagValidator(obj any) error {
+	val := reflect.ValueOf(obj)
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		if field.Tag.Get("validate") == "required" && val.Field(i).IsZero() {
+			return fmt.Errorf("%s is required", field.Name)
+		}
+	}
+	return nil
+}
+
+func TestStructMapper_WithStructValidation(t *testing.T) {
+	fieldMappings := []gomorph.FieldMapper{
+		gomorph.From[string, string]("InputString").
+			To("MappedInputString").
+			SkipConversion().
+			SkipValidation().
+			Build(),
+	}
+
+	outputMapper := gomorph.NewStructMapper[Input, ValidatedOutput](
+		fieldMappings,
+		gomorph.WithStructValidation(requiredTagValidator),
+	)
+
+	t.Run("valid destination passes", func(t *testing.T) {
+		result, err := outputMapper.From(Input{InputString: "hello"})
+		require.NoError(t, err)
+		require.Equal(t, "hello", result.MappedInputString)
+	})
+
+	t.Run("invalid destination surfaces a StructValidationError", func(t *testing.T) {
+		_, err := outputMapper.From(Input{})
+		require.Error(t, err)
+
+		var validationErr *gomorph.StructValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, reflect.TypeOf(ValidatedOutput{}), validationErr.Type)
+		require.EqualError(t, validationErr.Unwrap(), "MappedInputString is required")
+	})
+}
+
+func TestStructMapper_CollectFieldErrors(t *testing.T) {
+	failing := gomorph.From[string, string]("InputString").
+		To("MappedInputString").
+		ConvertWith(AlwaysFailingMapper{}).
+		SkipValidation()
+	missing := gomorph.From[int, int]("Missing").To("MappedInputInt").SkipConversion().SkipValidation()
+	working := gomorph.From[int, int]("InputInt").To("MappedInputInt").SkipConversion().SkipValidation()
+
+	mapper := gomorph.NewStructMapper[Input, Output](
+		[]gomorph.FieldMapper{failing.Build(), missing.Build(), working.Build()},
+		gomorph.CollectFieldErrors(),
+	)
+	result, err := mapper.From(Input{InputString: "a", InputInt: 7})
+	assert.Equal(t, Output{MappedInputInt: 7}, result, "successful fields are still written")
+
+	var fieldErrs gomorph.FieldErrors
+	require.ErrorAs(t, err, &fieldErrs)
+	require.Len(t, fieldErrs, 2)
+	assert.EqualError(t, err, "2 field(s) failed: "+
+		"mapping error [InputString]: mapper chain failed at step 1 (gomorph_test.AlwaysFailingMapper): always fails error value; "+
+		`input error [Missing]: field or zero-arg getter "Missing" not found on gomorph_test.Input`)
+	assert.ErrorIs(t, err, gomorph.ErrFieldNotFound)
+
+	gated := gomorph.NewStructMapper[Input, Output](
+		[]gomorph.FieldMapper{failing.Build(), missing.Gating().Build(), working.Build()},
+		gomorph.CollectFieldErrors(),
+	)
+	_, err = gated.From(Input{InputString: "a", InputInt: 7})
+	assert.EqualError(t, err, `input error [Missing]: field or zero-arg getter "Missing" not found on gomorph_test.Input`,
+		"gating failures still abort immediately")
+}
+
+type ContactDTO struct {
+	Email string
+	Phone string
+}
+
+type Contact struct {
+	Email   string
+	Phone   string
+	SlackID string
+}
+
+func TestStructMapper_OneOfFieldsSet(t *testing.T) {
+	fieldMappings := []gomorph.FieldMapper{
+		gomorph.From[string, string]("Email").To("Email").SkipConversion().SkipValidation().Build(),
+		gomorph.From[string, string]("Phone").To("Phone").SkipConversion().SkipValidation().Build(),
+	}
+	mapper := gomorph.NewStructMapper[ContactDTO, Contact](fieldMappings,
+		gomorph.OneOfFieldsSet("Email", "Phone", "SlackID"),
+	)
+
+	result, err := mapper.From(ContactDTO{Email: "a@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "a@example.com", result.Email)
+
+	_, err = mapper.From(ContactDTO{})
+	require.NoError(t, err, "no field set is allowed")
+
+	_, err = mapper.From(ContactDTO{Email: "a@example.com", Phone: "555"})
+	var validationErr *gomorph.StructValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.EqualError(t, validationErr.Unwrap(), "only one of Email, Phone, SlackID may be set, got Email and Phone")
+
+	unknown := gomorph.NewStructMapper[ContactDTO, Contact](fieldMappings, gomorph.OneOfFieldsSet("Email", "Fax"))
+	_, err = unknown.From(ContactDTO{})
+	assert.ErrorContains(t, err, `field "Fax" not found on gomorph_test.Contact`)
+
+	assert.Panics(t, func() { gomorph.OneOfFieldsSet("Email") })
+}
+
+type labelled struct {
+	Label string
+}
+
+// AccessorInput has both a promoted Label field and a Label() getter.
+type AccessorInput struct {
+	labelled
+}
+
+func (a AccessorInput) Label() string {
+	return "from getter"
+}
+
+type AccessorOutput struct {
+	Label string
+}
+
+func TestStructMapper_WithAccessors(t *testing.T) {
+	fieldMappings := []gomorph.FieldMapper{
+		gomorph.From[string, string]("Label").
+			To("Label").
+			SkipConversion().
+			SkipValidation().
+			Build(),
+	}
+	input := AccessorInput{labelled{Label: "from field"}}
+
+	tests := []struct {
+		name      string
+		accessors []gomorph.AccessorKind
+		expected  string
+		wantErr   string
+	}{
+		{
+			name:     "default order prefers the field",
+			expected: "from field",
+		},
+		{
+			name:      "method first prefers the getter",
+			accessors: []gomorph.AccessorKind{gomorph.AccessMethod, gomorph.AccessField},
+			expected:  "from getter",
+		},
+		{
+			name:      "disabled accessors are never tried",
+			accessors: []gomorph.AccessorKind{gomorph.AccessMapKey},
+			wantErr:   `input error [Label]: field or zero-arg getter "Label" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []gomorph.StructOption
+			if tt.accessors != nil {
+				opts = append(opts, gomorph.WithAccessors(tt.accessors...))
+			}
+			mapper := gomorph.NewStructMapper[AccessorInput, AccessorOutput](fieldMappings, opts...)
+
+			result, err := mapper.From(input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result.Label)
+		})
+	}
+}
+
+type GatedInput struct {
+	ID    string
+	Level string
+}
+
+type GatedOutput struct {
+	ID    int
+	Level int
+}
+
+func TestStructMapper_GatingFieldRunsFirst(t *testing.T) {
+	levelMapping := gomorph.From[string, int]("Level").
+		To("Level").
+		ConvertWith(StringToIntConverter{}).
+		SkipValidation().
+		Build()
+	idMapping := gomorph.From[string, int]("ID").
+		To("ID").
+		ConvertWith(StringToIntConverter{}).
+		SkipValidation().
+		Gating().
+		Build()
+
+	require.True(t, idMapping.IsGating())
+	require.False(t, levelMapping.IsGating())
+
+	mapper := gomorph.NewStructMapper[GatedInput, GatedOutput]([]gomorph.FieldMapper{levelMapping, idMapping})
+
+	_, err := mapper.From(GatedInput{ID: "not-an-id", Level: "also-bad"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "mapping error [ID]")
+
+	result, err := mapper.From(GatedInput{ID: "7", Level: "3"})
+	require.NoError(t, err)
+	require.Equal(t, GatedOutput{ID: 7, Level: 3}, result)
+}
+
+func TestChainedMapper_CanAccept(t *testing.T) {
+	chain := gomorph.NewChainedMapper[string, int](StringToIntMapper{}, IntDoubler{})
+
+	assert.NoError(t, chain.CanAccept("hello"))
+	assert.EqualError(t, chain.CanAccept(42), "chain cannot accept int: expected string")
+	assert.EqualError(t, chain.CanAccept(nil), "chain cannot accept nil: expected string")
+
+	type Label string
+	assert.Error(t, chain.CanAccept(Label("x")), "defined types are not assignable to string")
+
+	anyChain := gomorph.NewChainedMapper[any, string](gomorph.AssertType[string]())
+	assert.NoError(t, anyChain.CanAccept(42), "anything is assignable to an interface")
+	assert.NoError(t, anyChain.CanAccept(nil))
+
+	empty := gomorph.NewChainedMapper[*string, *string]()
+	assert.NoError(t, empty.CanAccept(nil))
+}
+
+type Address struct {
+	City string
+}
+
+type Customer struct {
+	Name    string
+	Address Address
+}
+
+func TestSelectField(t *testing.T) {
+	customer := Customer{Name: "Ada", Address: Address{City: "London"}}
+
+	address, err := gomorph.SelectField("Address").From(customer)
+	require.NoError(t, err)
+	require.Equal(t, Address{City: "London"}, address)
+
+	chain := gomorph.NewChainedMapper[any, string](
+		gomorph.SelectField("Address"),
+		gomorph.SelectField("City"),
+		gomorph.AssertType[string](),
+	)
+	city, err := chain.Map(customer)
+	require.NoError(t, err)
+	require.Equal(t, "London", city)
+
+	fromRecord, err := gomorph.SelectField("name").From(gomorph.Record{"name": "Ada"})
+	require.NoError(t, err)
+	require.Equal(t, "Ada", fromRecord)
+
+	_, err = gomorph.SelectField("Missing").From(customer)
+	require.EqualError(t, err, `field or zero-arg getter "Missing" not found on gomorph_test.Customer`)
+}
+
+// SkipPlaceholderMapper skips fields holding a placeholder instead of a real value.
+type SkipPlaceholderMapper struct {
+	gomorph.TypeMap[string, string]
+}
+
+func (m SkipPlaceholderMapper) From(source any) (any, error) {
+	if source.(string) == "N/A" {
+		return nil, gomorph.ErrSkipField
+	}
+	return source, nil
+}
+
+func TestStructMapper_ErrSkipField(t *testing.T) {
+	fieldMappings := []gomorph.FieldMapper{
+		gomorph.From[string, string]("InputString").
+			To("MappedInputString").
+			ConvertWith(SkipPlaceholderMapper{}).
+			SkipValidation().
+			Build(),
+		gomorph.From[int, int]("InputInt").
+			To("MappedInputInt").
+			SkipConversion().
+			SkipValidation().
+			Build(),
+	}
+	mapper := gomorph.NewStructMapper[Input, Output](fieldMappings)
+
+	result, err := mapper.From(Input{InputString: "N/A", InputInt: 3})
+	require.NoError(t, err)
+	require.Equal(t, Output{MappedInputInt: 3}, result, "skipped field keeps its zero value")
+
+	result, err = mapper.From(Input{InputString: "real", InputInt: 3})
+	require.NoError(t, err)
+	require.Equal(t, Output{MappedInputString: "real", MappedInputInt: 3}, result)
+}
+
+func TestStructMapper_Describe(t *testing.T) {
+	fieldMappings := []gomorph.FieldMapper{
+		gomorph.From[string, string]("InputString").
+			To("MappedInputString").
+			SkipConversion().
+			SkipValidation().
+			WithMetadata(map[string]string{"pii": "true"}).
+			WithMetadata(map[string]string{"source": "crm"}).
+			Build(),
+		gomorph.From[int, int]("InputInt").
+			To("MappedInputInt").
+			SkipConversion().
+			SkipValidation().
+			Build(),
+	}
+	mapper := gomorph.NewStructMapper[Input, Output](fieldMappings)
+
+	require.Equal(t, []gomorph.FieldMappingDescription{
+		{
+			Name:     "MappedInputString",
+			From:     "InputString",
+			FromType: reflect.TypeOf(""),
+			To:       "MappedInputString",
+			ToType:   reflect.TypeOf(""),
+			Metadata: map[string]string{"pii": "true", "source": "crm"},
+		},
+		{
+			Name:     "MappedInputInt",
+			From:     "InputInt",
+			FromType: reflect.TypeOf(0),
+			To:       "MappedInputInt",
+			ToType:   reflect.TypeOf(0),
+		},
+	}, mapper.Describe())
+
+	result, err := mapper.From(Input{InputString: "a", InputInt: 1})
+	require.NoError(t, err)
+	require.Equal(t, Output{MappedInputString: "a", MappedInputInt: 1}, result, "metadata does not change mapping")
+
+	metadata := fieldMappings[0].(gomorph.MetadataFieldMapper).Metadata()
+	metadata["pii"] = "false"
+	require.Equal(t, "true", fieldMappings[0].(gomorph.MetadataFieldMapper).Metadata()["pii"], "metadata is returned as a copy")
+}
+
+type Tags []string
+
+type ClassifiedCharacter struct {
+	CharClass CharacterClass
+	Tags      Tags
+	Level     string
+}
+
+func TestStructMapper_CoercesSameKindDefinedTypes(t *testing.T) {
+	identity := func(from, to string) gomorph.FieldMapper {
+		return gomorph.From[any, any](from).To(to).SkipConversion().SkipValidation().Build()
+	}
+
+	mapper := gomorph.NewStructMapper[gomorph.Record, ClassifiedCharacter]([]gomorph.FieldMapper{
+		identity("class", "CharClass"),
+		identity("tags", "Tags"),
+	})
+
+	result, err := mapper.From(gomorph.Record{"class": "Wizard", "tags": []string{"npc"}})
+	require.NoError(t, err)
+	require.Equal(t, ClassifiedCharacter{CharClass: "Wizard", Tags: Tags{"npc"}}, result)
+
+	lossy := gomorph.NewStructMapper[gomorph.Record, ClassifiedCharacter]([]gomorph.FieldMapper{
+		identity("level", "Level"),
+	})
+	_, err = lossy.From(gomorph.Record{"level": 65})
+	require.EqualError(t, err, "output error [Level]: type mismatch: cannot assign int to string")
+}
+
+// ClassLabeller declares string output but returns its defined CharacterClass type.
+type ClassLabeller struct {
+	gomorph.TypeMap[string, string]
+}
+
+func (m ClassLabeller) From(source any) (any, error) {
+	return CharacterClass(source.(string)), nil
+}
+
+func TestChainedMapper_WithCoercion(t *testing.T) {
+	strict := gomorph.NewChainedMapper[string, int](ClassLabeller{}, StringToIntMapper{})
+	_, err := strict.Map("Wizard")
+	require.EqualError(t, err, "mapper chain failed at step 2 (gomorph_test.StringToIntMapper): expected string, got gomorph_test.CharacterClass")
+
+	coerced := strict.WithCoercion()
+	result, err := coerced.Map("Wizard")
+	require.NoError(t, err)
+	require.Equal(t, 6, result)
+
+	_, err = strict.Map("Wizard")
+	require.Error(t, err, "WithCoercion does not modify the original chain")
+
+	final := gomorph.NewChainedMapper[string, string](ClassLabeller{})
+	_, err = final.Map("Rogue")
+	require.EqualError(t, err, "final type mismatch: expected string, got gomorph_test.CharacterClass")
+
+	label, err := final.WithCoercion().Map("Rogue")
+	require.NoError(t, err)
+	require.Equal(t, "Rogue", label)
+}
+
+// DriftingFieldMapper declares an int target but produces strings.
+type DriftingFieldMapper struct{}
+
+func (DriftingFieldMapper) From() gomorph.Field { return gomorph.NewField[string]("InputString") }
+func (DriftingFieldMapper) To() gomorph.Field   { return gomorph.NewField[int]("MappedInputInt") }
+func (DriftingFieldMapper) Map(value any) (gomorph.FieldMappingResult, error) {
+	return gomorph.NewFieldMappingResult(gomorph.NewField[int]("MappedInputInt"), gomorph.NewTypedValue(value)), nil
+}
+
+func TestStructMapper_ChecksDeclaredTargetType(t *testing.T) {
+	mapper := gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{DriftingFieldMapper{}})
+	_, err := mapper.From(Input{InputString: "7"})
+	require.EqualError(t, err, "output error [MappedInputInt]: mapping InputString -> MappedInputInt: converter produced string, but the target field is declared as int")
+}
+
+func TestStructMapper_MissingFieldMatchesErrFieldNotFound(t *testing.T) {
+	mapper := gomorph.NewStructMapper[gomorph.Record, Output]([]gomorph.FieldMapper{
+		gomorph.From[any, any]("name").To("MappedInputString").SkipConversion().SkipValidation().Build(),
+	})
+
+	_, err := mapper.From(gomorph.Record{})
+	require.ErrorIs(t, err, gomorph.ErrFieldNotFound)
+
+	var notFound *gomorph.FieldNotFoundError
+	require.ErrorAs(t, err, &notFound)
+	require.Equal(t, "name", notFound.Name)
+}
+
+func TestChainedMapper_ConcurrentUse(t *testing.T) {
+	// Run with -race: one chain is shared by several FieldMappings and called directly.
+	shared := gomorph.NewChainedMapper[string, int](StringToIntMapper{}, IntDoubler{})
+	mapper := gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{
+		gomorph.NewFieldMapping(gomorph.NewField[string]("InputString"), gomorph.NewField[int]("MappedInputInt"), shared),
+		gomorph.NewFieldMapping(gomorph.NewField[string]("InputString"), gomorph.NewField[int]("MappedInputInt"), shared.WithCoercion()),
+	})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 100)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			input := strings.Repeat("x", n)
+			if got, err := shared.Map(input); err != nil || got != 2*n {
+				errs <- fmt.Errorf("chain: got %v, %v for length %d", got, err, n)
+			}
+			if out, err := mapper.From(Input{InputString: input}); err != nil || out.MappedInputInt != 2*n {
+				errs <- fmt.Errorf("struct: got %+v, %v for length %d", out, err, n)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+type AuditSource struct {
+	Name  string
+	Level int
+	Note  string
+}
+
+type AuditDest struct {
+	Name   string
+	Length int
+	Level  int
+	Note   string
+}
+
+func TestStructMapper_FromVerbose(t *testing.T) {
+	mapper := gomorph.NewStructMapper[AuditSource, AuditDest]([]gomorph.FieldMapper{
+		gomorph.From[string, string]("Name").To("Name").SkipConversion().SkipValidation().Build(),
+		gomorph.From[string, int]("Name").To("Length").ConvertWith(StringToIntMapper{}).SkipValidation().Build(),
+		gomorph.From[int, int]("Level").To("Level").SkipConversion().ValidateWith(IntDoubler{}).Build(),
+		gomorph.From[string, string]("Note").To("Note").ConvertWith(SkipPlaceholderMapper{}).SkipValidation().Build(),
+	})
+
+	output, report, err := mapper.FromVerbose(AuditSource{Name: "Ada", Level: 2, Note: "N/A"})
+	require.NoError(t, err)
+	require.Equal(t, AuditDest{Name: "Ada", Length: 3, Level: 4}, output)
+	require.Equal(t, []gomorph.FieldReport{
+		{From: "Name", To: "Name", Status: gomorph.FieldCopied},
+		{From: "Name", To: "Length", Status: gomorph.FieldConverted, Steps: []string{"gomorph_test.StringToIntMapper"}},
+		{From: "Level", To: "Level", Status: gomorph.FieldValidated, Steps: []string{"gomorph_test.IntDoubler"}},
+		{From: "Note", To: "Note", Status: gomorph.FieldSkipped},
+	}, report)
+
+	plain, err := mapper.From(AuditSource{Name: "Ada", Level: 2, Note: "N/A"})
+	require.NoError(t, err)
+	require.Equal(t, output, plain, "verbose mode does not change the result")
+}
+
+func TestStructMapper_FromVerboseReportsFailure(t *testing.T) {
+	mapper := gomorph.NewStructMapper[Input, Output]([]gomorph.FieldMapper{
+		DriftingFieldMapper{},
+	})
+
+	_, report, err := mapper.FromVerbose(Input{InputString: "x"})
+	require.Error(t, err)
+	require.Len(t, report, 1)
+	require.Equal(t, gomorph.FieldFailed, report[0].Status)
+	require.Equal(t, err, report[0].Err)
+	require.Equal(t, "failed", report[0].Status.String())
+}
@@ -0,0 +1,258 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var _ MultiFieldMapper = (*MapExpansion)(nil)
+
+// MapExpansion is a MultiFieldMapper that spreads the entries of a map-valued source field over
+// individually named target fields. Create one with ExpandMap.
+type MapExpansion struct {
+	from    Field
+	keys    []string
+	targets map[string]Field
+}
+
+// ExpandMap maps the Record held in the source field from onto several target fields, assigning
+// the value under each key to the Field declared for it in keyToField. It is meant for
+// denormalizing metadata or blob columns into typed struct fields:
+//
+//	gomorph.ExpandMap("Metadata", map[string]gomorph.Field{
+//	    "team":     gomorph.NewField[string]("Team"),
+//	    "priority": gomorph.NewField[int]("Priority"),
+//	})
+//
+// Every key present in the source map must have a declared target and its value must be
+// assignable to the target field's type; anything else fails the mapping so no data is silently
+// dropped. Declared keys missing from the map leave their target fields untouched. keyToField is
+// copied on construction.
+func ExpandMap(from string, keyToField map[string]Field) *MapExpansion {
+	keys := make([]string, 0, len(keyToField))
+	targets := make(map[string]Field, len(keyToField))
+	for key, field := range keyToField {
+		keys = append(keys, key)
+		targets[key] = field
+	}
+	sort.Strings(keys)
+
+	return &MapExpansion{
+		from:    NewField[Record](from),
+		keys:    keys,
+		targets: targets,
+	}
+}
+
+func (m *MapExpansion) From() Field {
+	return m.from
+}
+
+// To describes all target fields as one Field named after them.
+func (m *MapExpansion) To() Field {
+	names := make([]string, 0, len(m.keys))
+	for _, key := range m.keys {
+		names = append(names, m.targets[key].Name())
+	}
+	return fieldInfo{name: strings.Join(names, ",")}
+}
+
+func (m *MapExpansion) Targets() []Field {
+	targets := make([]Field, 0, len(m.keys))
+	for _, key := range m.keys {
+		targets = append(targets, m.targets[key])
+	}
+	return targets
+}
+
+// Map is not supported because the expansion writes several fields; use MapAll.
+func (m *MapExpansion) Map(value any) (FieldMappingResult, error) {
+	return NewFieldMappingResult(m.To(), NewTypedValue(nil)),
+		fmt.Errorf("map expansion of %q writes multiple fields; use MapAll", m.from.Name())
+}
+
+func (m *MapExpansion) MapAll(value any) ([]FieldMappingResult, error) {
+	record, ok := value.(Record)
+	if !ok {
+		return nil, fmt.Errorf("invalid source type: expected %v, got %T", reflect.TypeFor[Record](), value)
+	}
+
+	present := make([]string, 0, len(record))
+	for key := range record {
+		if _, declared := m.targets[key]; !declared {
+			return nil, fmt.Errorf("key %q has no declared target field", key)
+		}
+		present = append(present, key)
+	}
+	sort.Strings(present)
+
+	results := make([]FieldMappingResult, 0, len(present))
+	for _, key := range present {
+		target := m.targets[key]
+		entry := record[key]
+		if entry != nil && target.Type() != nil && !reflect.TypeOf(entry).AssignableTo(target.Type()) {
+			return nil, fmt.Errorf("key %q: cannot assign %T to field %q of type %v", key, entry, target.Name(), target.Type())
+		}
+		results = append(results, NewFieldMappingResult(target, NewTypedValue(entry)))
+	}
+	return results, nil
+}
+
+var _ AccessorFieldMapper = (*FieldCombination)(nil)
+
+// FieldCombination is a FieldMapper that builds one target field from several source fields.
+// Create one with CombineFields.
+type FieldCombination struct {
+	from    []Field
+	to      Field
+	combine func(values []any) (any, error)
+}
+
+// CombineFields maps the source fields from onto the single target field to. Each source field is
+// read by name and checked against its declared type; combine receives the values in the order
+// of from and returns the value to assign.
+//
+// Example:
+//
+//	gomorph.CombineFields(
+//	    []gomorph.Field{gomorph.NewField[string]("FirstName"), gomorph.NewField[string]("LastName")},
+//	    gomorph.NewField[string]("FullName"),
+//	    func(values []any) (any, error) {
+//	        return values[0].(string) + " " + values[1].(string), nil
+//	    },
+//	)
+func CombineFields(from []Field, to Field, combine func(values []any) (any, error)) *FieldCombination {
+	if len(from) == 0 || combine == nil {
+		panic("CombineFields: needs at least one source field and a combine function")
+	}
+	return &FieldCombination{from: from, to: to, combine: combine}
+}
+
+// From describes all source fields as one Field named after them.
+func (m *FieldCombination) From() Field {
+	names := make([]string, 0, len(m.from))
+	for _, field := range m.from {
+		names = append(names, field.Name())
+	}
+	return fieldInfo{name: strings.Join(names, ",")}
+}
+
+func (m *FieldCombination) To() Field {
+	return m.to
+}
+
+// SourceAccessor reads every source field, producing the []any passed to Map.
+func (m *FieldCombination) SourceAccessor() SourceAccessor {
+	return func(source any) (any, error) {
+		values := make([]any, 0, len(m.from))
+		for _, field := range m.from {
+			value, err := getFieldValueByName(source, field.Name())
+			if err != nil {
+				return nil, err
+			}
+			if value != nil && field.Type() != nil && !reflect.TypeOf(value).AssignableTo(field.Type()) {
+				return nil, fmt.Errorf("field %q: expected %v, got %T", field.Name(), field.Type(), value)
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	}
+}
+
+// Map expects the values produced by SourceAccessor.
+func (m *FieldCombination) Map(value any) (FieldMappingResult, error) {
+	values, ok := value.([]any)
+	if !ok || len(values) != len(m.from) {
+		return NewFieldMappingResult(m.to, NewTypedValue(nil)),
+			fmt.Errorf("invalid source: expected %d field values, got %T", len(m.from), value)
+	}
+	combined, err := m.combine(values)
+	if err != nil {
+		return NewFieldMappingResult(m.to, NewTypedValue(nil)), err
+	}
+	return NewFieldMappingResult(m.to, NewTypedValue(combined)), nil
+}
+
+var _ MultiFieldMapper = (*FieldSplit)(nil)
+
+// FieldSplit is a MultiFieldMapper that derives several target fields from one source field.
+// Create one with SplitField.
+type FieldSplit struct {
+	from    Field
+	targets []Field
+	split   func(value any) (map[string]any, error)
+}
+
+// SplitField maps the source field from onto the targets using split, which returns the value for
+// each target keyed by target field name. A key that names no declared target, or a value that
+// does not fit its target's type, fails the mapping; declared targets missing from the result are
+// left untouched.
+//
+// Example:
+//
+//	gomorph.SplitField(gomorph.NewField[string]("Coordinates"),
+//	    []gomorph.Field{gomorph.NewField[float64]("Lat"), gomorph.NewField[float64]("Lng")},
+//	    splitCoordinates,
+//	)
+func SplitField(from Field, targets []Field, split func(value any) (map[string]any, error)) *FieldSplit {
+	if len(targets) == 0 || split == nil {
+		panic("SplitField: needs at least one target field and a split function")
+	}
+	return &FieldSplit{from: from, targets: targets, split: split}
+}
+
+func (m *FieldSplit) From() Field {
+	return m.from
+}
+
+// To describes all target fields as one Field named after them.
+func (m *FieldSplit) To() Field {
+	names := make([]string, 0, len(m.targets))
+	for _, target := range m.targets {
+		names = append(names, target.Name())
+	}
+	return fieldInfo{name: strings.Join(names, ",")}
+}
+
+func (m *FieldSplit) Targets() []Field {
+	return append([]Field(nil), m.targets...)
+}
+
+// Map is not supported because the split writes several fields; use MapAll.
+func (m *FieldSplit) Map(value any) (FieldMappingResult, error) {
+	return NewFieldMappingResult(m.To(), NewTypedValue(nil)),
+		fmt.Errorf("split of %q writes multiple fields; use MapAll", m.from.Name())
+}
+
+// MapAll returns one result per target present in the output of split, in declaration order.
+func (m *FieldSplit) MapAll(value any) ([]FieldMappingResult, error) {
+	if value != nil && m.from.Type() != nil && !reflect.TypeOf(value).AssignableTo(m.from.Type()) {
+		return nil, fmt.Errorf("invalid source type: expected %v, got %T", m.from.Type(), value)
+	}
+	parts, err := m.split(value)
+	if err != nil {
+		return nil, err
+	}
+
+	declared := make(map[string]bool, len(m.targets))
+	for _, target := range m.targets {
+		declared[target.Name()] = true
+	}
+	for name := range parts {
+		if !declared[name] {
+			return nil, fmt.Errorf("split produced %q, which is not a declared target field", name)
+		}
+	}
+
+	results := make([]FieldMappingResult, 0, len(parts))
+	for _, target := range m.targets {
+		part, ok := parts[target.Name()]
+		if !ok {
+			continue
+		}
+		results = append(results, NewFieldMappingResult(target, NewTypedValue(part)))
+	}
+	return results, nil
+}
@@ -0,0 +1,219 @@
+package gomorph_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type TicketRow struct {
+	Title    string
+	Metadata gomorph.Record
+}
+
+type Ticket struct {
+	Title    string
+	Team     string
+	Priority int
+}
+
+func TestExpandMap(t *testing.T) {
+	expansion := gomorph.ExpandMap("Metadata", map[string]gomorph.Field{
+		"team":     gomorph.NewField[string]("Team"),
+		"priority": gomorph.NewField[int]("Priority"),
+	})
+	assert.Equal(t, "Priority,Team", expansion.To().Name())
+	assert.Len(t, expansion.Targets(), 2)
+
+	mapper := gomorph.NewStructMapper[TicketRow, Ticket]([]gomorph.FieldMapper{
+		gomorph.From[string, string]("Title").To("Title").SkipConversion().SkipValidation().Build(),
+		expansion,
+	})
+
+	tests := []struct {
+		name     string
+		metadata gomorph.Record
+		expected Ticket
+		wantErr  string
+	}{
+		{
+			name:     "expands every declared key",
+			metadata: gomorph.Record{"team": "core", "priority": 2},
+			expected: Ticket{Title: "bug", Team: "core", Priority: 2},
+		},
+		{
+			name:     "missing keys leave fields untouched",
+			metadata: gomorph.Record{"team": "core"},
+			expected: Ticket{Title: "bug", Team: "core"},
+		},
+		{
+			name:     "undeclared keys are rejected",
+			metadata: gomorph.Record{"team": "core", "owner": "ada"},
+			wantErr:  `mapping error [Metadata]: key "owner" has no declared target field`,
+		},
+		{
+			name:     "mismatched types are rejected",
+			metadata: gomorph.Record{"priority": "high"},
+			wantErr:  `key "priority": cannot assign string to field "Priority" of type int`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mapper.From(TicketRow{Title: "bug", Metadata: tt.metadata})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestExpandMap_CopiesTable(t *testing.T) {
+	keyToField := map[string]gomorph.Field{"team": gomorph.NewField[string]("Team")}
+	expansion := gomorph.ExpandMap("Metadata", keyToField)
+	keyToField["team"] = gomorph.NewField[string]("Title")
+	keyToField["priority"] = gomorph.NewField[int]("Priority")
+
+	results, err := expansion.MapAll(gomorph.Record{"team": "core"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "Team", results[0].TargetField().Name())
+
+	_, err = expansion.MapAll(gomorph.Record{"priority": 1})
+	assert.EqualError(t, err, `key "priority" has no declared target field`)
+}
+
+type PersonRow struct {
+	FirstName string
+	LastName  string
+	Age       int
+}
+
+type Person struct {
+	FullName string
+}
+
+func TestCombineFields(t *testing.T) {
+	joinNames := func(values []any) (any, error) {
+		return values[0].(string) + " " + values[1].(string), nil
+	}
+
+	tests := []struct {
+		name    string
+		from    []gomorph.Field
+		source  PersonRow
+		want    Person
+		wantErr string
+	}{
+		{
+			name:   "combines two strings",
+			from:   []gomorph.Field{gomorph.NewField[string]("FirstName"), gomorph.NewField[string]("LastName")},
+			source: PersonRow{FirstName: "Ada", LastName: "Lovelace"},
+			want:   Person{FullName: "Ada Lovelace"},
+		},
+		{
+			name:    "missing source field",
+			from:    []gomorph.Field{gomorph.NewField[string]("FirstName"), gomorph.NewField[string]("Surname")},
+			wantErr: `input error [FirstName,Surname]: field or zero-arg getter "Surname" not found on gomorph_test.PersonRow`,
+		},
+		{
+			name:    "mismatched source type",
+			from:    []gomorph.Field{gomorph.NewField[string]("FirstName"), gomorph.NewField[string]("Age")},
+			wantErr: `input error [FirstName,Age]: field "Age": expected string, got int`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := gomorph.NewStructMapper[PersonRow, Person]([]gomorph.FieldMapper{
+				gomorph.CombineFields(tt.from, gomorph.NewField[string]("FullName"), joinNames),
+			})
+			result, err := mapper.From(tt.source)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+type PlaceRow struct {
+	Coordinates string
+}
+
+type Place struct {
+	Lat float64
+	Lng float64
+}
+
+func TestSplitField(t *testing.T) {
+	splitCoordinates := func(value any) (map[string]any, error) {
+		lat, lng, ok := strings.Cut(value.(string), ",")
+		if !ok {
+			return nil, fmt.Errorf("expected \"lat,lng\", got %q", value)
+		}
+		parts := map[string]any{}
+		for name, raw := range map[string]string{"Lat": lat, "Lng": lng} {
+			if raw == "" {
+				continue
+			}
+			f, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+			if err != nil {
+				return nil, err
+			}
+			parts[name] = f
+		}
+		return parts, nil
+	}
+	split := gomorph.SplitField(
+		gomorph.NewField[string]("Coordinates"),
+		[]gomorph.Field{gomorph.NewField[float64]("Lat"), gomorph.NewField[float64]("Lng")},
+		splitCoordinates,
+	)
+	assert.Equal(t, "Lat,Lng", split.To().Name())
+	assert.Len(t, split.Targets(), 2)
+
+	mapper := gomorph.NewStructMapper[PlaceRow, Place]([]gomorph.FieldMapper{split})
+
+	tests := []struct {
+		name        string
+		coordinates string
+		want        Place
+		wantErr     string
+	}{
+		{name: "splits both parts", coordinates: "52.52, 13.405", want: Place{Lat: 52.52, Lng: 13.405}},
+		{name: "missing part leaves field untouched", coordinates: "52.52,", want: Place{Lat: 52.52}},
+		{name: "malformed input", coordinates: "52.52", wantErr: `mapping error [Coordinates]: expected "lat,lng", got "52.52"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mapper.From(PlaceRow{Coordinates: tt.coordinates})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+
+	undeclared := gomorph.NewStructMapper[PlaceRow, Place]([]gomorph.FieldMapper{
+		gomorph.SplitField(gomorph.NewField[string]("Coordinates"),
+			[]gomorph.Field{gomorph.NewField[float64]("Lat")},
+			func(any) (map[string]any, error) { return map[string]any{"Alt": 1.0}, nil },
+		),
+	})
+	_, err := undeclared.From(PlaceRow{})
+	assert.EqualError(t, err, `mapping error [Coordinates]: split produced "Alt", which is not a declared target field`)
+}
@@ -0,0 +1,173 @@
+package gomorph
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"reflect"
+)
+
+// ParseIP parses an IPv4 or IPv6 address (string -> netip.Addr).
+func ParseIP() TypedMapper {
+	return namedFunc[string, netip.Addr]("ParseIP", func(s string) (netip.Addr, error) {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Addr{}, fmt.Errorf("invalid IP address %q: %w", s, err)
+		}
+		return addr, nil
+	})
+}
+
+// FormatIP formats an address in its canonical string form (netip.Addr -> string).
+func FormatIP() TypedMapper {
+	return namedFunc[netip.Addr, string]("FormatIP", func(addr netip.Addr) (string, error) {
+		if !addr.IsValid() {
+			return "", fmt.Errorf("invalid IP address: zero value")
+		}
+		return addr.String(), nil
+	})
+}
+
+// ParseCIDR parses a CIDR block such as "10.0.0.0/8" (string -> netip.Prefix). The prefix is
+// kept as written; host bits are not masked off.
+func ParseCIDR() TypedMapper {
+	return namedFunc[string, netip.Prefix]("ParseCIDR", func(s string) (netip.Prefix, error) {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR %q: %w", s, err)
+		}
+		return prefix, nil
+	})
+}
+
+// FormatCIDR formats a prefix in CIDR notation (netip.Prefix -> string).
+func FormatCIDR() TypedMapper {
+	return namedFunc[netip.Prefix, string]("FormatCIDR", func(prefix netip.Prefix) (string, error) {
+		if !prefix.IsValid() {
+			return "", fmt.Errorf("invalid CIDR: zero value")
+		}
+		return prefix.String(), nil
+	})
+}
+
+// URLQueryUnescape decodes a query-string component (string -> string) with url.QueryUnescape,
+// so "+" becomes a space and "%2F" a slash. Malformed escapes wrap the url.EscapeError.
+func URLQueryUnescape() TypedMapper {
+	return namedFunc[string, string]("URLQueryUnescape", func(s string) (string, error) {
+		decoded, err := url.QueryUnescape(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid query escape in %q: %w", s, err)
+		}
+		return decoded, nil
+	})
+}
+
+// URLQueryEscape is the inverse of URLQueryUnescape (string -> string).
+func URLQueryEscape() TypedMapper {
+	return namedFunc[string, string]("URLQueryEscape", func(s string) (string, error) {
+		return url.QueryEscape(s), nil
+	})
+}
+
+// URLPathUnescape decodes a URL path segment (string -> string) with url.PathUnescape. Unlike
+// URLQueryUnescape it leaves "+" as is. Malformed escapes wrap the url.EscapeError.
+func URLPathUnescape() TypedMapper {
+	return namedFunc[string, string]("URLPathUnescape", func(s string) (string, error) {
+		decoded, err := url.PathUnescape(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid path escape in %q: %w", s, err)
+		}
+		return decoded, nil
+	})
+}
+
+// URLPathEscape is the inverse of URLPathUnescape (string -> string).
+func URLPathEscape() TypedMapper {
+	return namedFunc[string, string]("URLPathEscape", func(s string) (string, error) {
+		return url.PathEscape(s), nil
+	})
+}
+
+// MapToQueryString encodes a map as a URL query string (map[string]any -> string), for building
+// form-encoded request bodies. Keys are sorted so the output is deterministic, and keys and values
+// are escaped with url.QueryEscape. Strings, bools and numbers are formatted with fmt.Sprint,
+// []byte as its text and fmt.Stringer values with String. Other slices and arrays repeat the key
+// once per element ("tag=a&tag=b"), and nil values encode as an empty value. Any other value, such
+// as a map or struct, has no query form and is an error.
+func MapToQueryString() TypedMapper {
+	return namedFunc[map[string]any, string]("MapToQueryString", func(m map[string]any) (string, error) {
+		values := make(url.Values, len(m))
+		for key, value := range m {
+			encoded, err := queryValues(value)
+			if err != nil {
+				return "", fmt.Errorf("key %q: %w", key, err)
+			}
+			values[key] = encoded
+		}
+		return values.Encode(), nil
+	})
+}
+
+// QueryStringToMap is the reverse of MapToQueryString (string -> map[string]any), parsed with
+// url.ParseQuery. A key given once maps to its string value; a repeated key maps to a []string
+// in the order the values appear. A leading "?" is ignored.
+func QueryStringToMap() TypedMapper {
+	return namedFunc[string, map[string]any]("QueryStringToMap", func(s string) (map[string]any, error) {
+		if len(s) > 0 && s[0] == '?' {
+			s = s[1:]
+		}
+		values, err := url.ParseQuery(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query string %q: %w", s, err)
+		}
+		result := make(map[string]any, len(values))
+		for key, vs := range values {
+			if len(vs) == 1 {
+				result[key] = vs[0]
+			} else {
+				result[key] = vs
+			}
+		}
+		return result, nil
+	})
+}
+
+func queryValues(value any) ([]string, error) {
+	val := reflect.ValueOf(value)
+	if _, isBytes := value.([]byte); !isBytes && (val.Kind() == reflect.Slice || val.Kind() == reflect.Array) {
+		out := make([]string, val.Len())
+		for i := range out {
+			s, err := queryValue(val.Index(i).Interface())
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			out[i] = s
+		}
+		return out, nil
+	}
+	s, err := queryValue(value)
+	if err != nil {
+		return nil, err
+	}
+	return []string{s}, nil
+}
+
+// queryValue formats a single query value.
+func queryValue(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case []byte:
+		return string(v), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprint(value), nil
+	}
+	return "", fmt.Errorf("unsupported query value of type %T", value)
+}
@@ -0,0 +1,121 @@
+package gomorph_test
+
+import (
+	"net/netip"
+	"net/url"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetConverters(t *testing.T) {
+	tests := []struct {
+		name     string
+		mapper   gomorph.TypedMapper
+		input    any
+		expected any
+		wantErr  string
+	}{
+		{name: "ipv4", mapper: gomorph.ParseIP(), input: "192.168.0.1", expected: netip.MustParseAddr("192.168.0.1")},
+		{name: "ipv6", mapper: gomorph.ParseIP(), input: "2001:db8::1", expected: netip.MustParseAddr("2001:db8::1")},
+		{name: "invalid ip", mapper: gomorph.ParseIP(), input: "300.1.1.1", wantErr: `invalid IP address "300.1.1.1"`},
+		{name: "format ip", mapper: gomorph.FormatIP(), input: netip.MustParseAddr("2001:0db8::0001"), expected: "2001:db8::1"},
+		{name: "format zero ip", mapper: gomorph.FormatIP(), input: netip.Addr{}, wantErr: "invalid IP address: zero value"},
+		{name: "cidr", mapper: gomorph.ParseCIDR(), input: "10.0.0.0/8", expected: netip.MustParsePrefix("10.0.0.0/8")},
+		{name: "invalid cidr", mapper: gomorph.ParseCIDR(), input: "10.0.0.0/33", wantErr: `invalid CIDR "10.0.0.0/33"`},
+		{name: "cidr without bits", mapper: gomorph.ParseCIDR(), input: "10.0.0.0", wantErr: `invalid CIDR "10.0.0.0"`},
+		{name: "format cidr", mapper: gomorph.FormatCIDR(), input: netip.MustParsePrefix("fd00::/8"), expected: "fd00::/8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.mapper.From(tt.input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestURLEscapeConverters(t *testing.T) {
+	tests := []struct {
+		name     string
+		mapper   gomorph.TypedMapper
+		input    string
+		expected string
+		wantErr  string
+	}{
+		{name: "query plus is space", mapper: gomorph.URLQueryUnescape(), input: "hello+world%21", expected: "hello world!"},
+		{name: "query slash", mapper: gomorph.URLQueryUnescape(), input: "a%2Fb", expected: "a/b"},
+		{name: "query invalid escape", mapper: gomorph.URLQueryUnescape(), input: "100%", wantErr: `invalid query escape in "100%"`},
+		{name: "query bad hex", mapper: gomorph.URLQueryUnescape(), input: "%zz", wantErr: `invalid query escape in "%zz"`},
+		{name: "query escape", mapper: gomorph.URLQueryEscape(), input: "a b&c", expected: "a+b%26c"},
+		{name: "path keeps plus", mapper: gomorph.URLPathUnescape(), input: "a+b%20c", expected: "a+b c"},
+		{name: "path invalid escape", mapper: gomorph.URLPathUnescape(), input: "%4", wantErr: `invalid path escape in "%4"`},
+		{name: "path escape", mapper: gomorph.URLPathEscape(), input: "a b/c", expected: "a%20b%2Fc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.mapper.From(tt.input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				var escapeErr url.EscapeError
+				require.ErrorAs(t, err, &escapeErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMapToQueryString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]any
+		expected string
+	}{
+		{name: "sorted keys", input: map[string]any{"b": 2, "a": "x"}, expected: "a=x&b=2"},
+		{name: "escaping", input: map[string]any{"q": "a b&c", "k y": "v"}, expected: "k+y=v&q=a+b%26c"},
+		{name: "slice repeats key", input: map[string]any{"tag": []string{"go", "web"}, "n": []int{1, 2}}, expected: "n=1&n=2&tag=go&tag=web"},
+		{name: "nil value", input: map[string]any{"empty": nil}, expected: "empty="},
+		{name: "bytes as text", input: map[string]any{"b": []byte("hi"), "bs": [][]byte{[]byte("a"), []byte("b")}}, expected: "b=hi&bs=a&bs=b"},
+		{name: "stringer", input: map[string]any{"ip": netip.MustParseAddr("10.0.0.1")}, expected: "ip=10.0.0.1"},
+		{name: "empty map", input: map[string]any{}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomorph.MapToQueryString().From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMapToQueryString_UnsupportedValues(t *testing.T) {
+	_, err := gomorph.MapToQueryString().From(map[string]any{"filter": map[string]any{"a": 1}})
+	assert.EqualError(t, err, `key "filter": unsupported query value of type map[string]interface {}`)
+
+	_, err = gomorph.MapToQueryString().From(map[string]any{"ids": []any{1, struct{}{}}})
+	assert.EqualError(t, err, `key "ids": element 1: unsupported query value of type struct {}`)
+}
+
+func TestQueryStringToMap(t *testing.T) {
+	got, err := gomorph.QueryStringToMap().From("?a=x&tag=go&tag=web&q=a+b%26c")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "x", "tag": []string{"go", "web"}, "q": "a b&c"}, got)
+
+	_, err = gomorph.QueryStringToMap().From("a=%zz")
+	assert.ErrorContains(t, err, `invalid query string "a=%zz"`)
+
+	encoded, err := gomorph.MapToQueryString().From(got)
+	require.NoError(t, err)
+	assert.Equal(t, "a=x&q=a+b%26c&tag=go&tag=web", encoded)
+}
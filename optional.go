@@ -0,0 +1,74 @@
+package gomorph
+
+// Optional models the presence or absence of a value explicitly, as a type-safe alternative to
+// using nil pointers for optional fields. The zero Optional is absent.
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Some returns an Optional holding value.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, present: true}
+}
+
+// None returns an absent Optional.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Value returns the held value and whether it is present. The value is the zero T when absent.
+func (o Optional[T]) Value() (T, bool) {
+	return o.value, o.present
+}
+
+// IsPresent reports whether the Optional holds a value.
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// OrElse returns the held value, or defaultVal when the Optional is absent.
+func (o Optional[T]) OrElse(defaultVal T) T {
+	if o.present {
+		return o.value
+	}
+	return defaultVal
+}
+
+// ToOptional wraps every incoming value in a present Optional (T -> Optional[T]).
+func ToOptional[T any]() TypedMapper {
+	return namedFunc[T, Optional[T]]("ToOptional", func(value T) (Optional[T], error) {
+		return Some(value), nil
+	})
+}
+
+// FromOptional unwraps an Optional, substituting defaultVal when it is absent (Optional[T] -> T).
+func FromOptional[T any](defaultVal T) TypedMapper {
+	return namedFunc[Optional[T], T]("FromOptional", func(o Optional[T]) (T, error) {
+		return o.OrElse(defaultVal), nil
+	})
+}
+
+// EmptyStringToNil turns the empty string into a nil *string and any other string into a pointer
+// to it (string -> *string), for optional pointer fields fed from JSON or forms.
+func EmptyStringToNil() TypedMapper {
+	return emptyToNil[string]("EmptyStringToNil")
+}
+
+// EmptyToNil generalizes EmptyStringToNil (T -> *T): the zero T becomes nil and any other value a
+// pointer to a copy of it.
+func EmptyToNil[T comparable]() TypedMapper {
+	return emptyToNil[T]("EmptyToNil")
+}
+
+// emptyToNil implements EmptyToNil and EmptyStringToNil, naming the step after the calling
+// constructor.
+func emptyToNil[T comparable](name string) TypedMapper {
+	return namedFunc[T, *T](name, func(value T) (*T, error) {
+		var zero T
+		if value == zero {
+			return nil, nil
+		}
+		return &value, nil
+	})
+}
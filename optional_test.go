@@ -0,0 +1,95 @@
+package gomorph_test
+
+import (
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptional(t *testing.T) {
+	value, ok := gomorph.Some(42).Value()
+	assert.True(t, ok)
+	assert.Equal(t, 42, value)
+
+	value, ok = gomorph.None[int]().Value()
+	assert.False(t, ok)
+	assert.Equal(t, 0, value)
+
+	var zero gomorph.Optional[string]
+	assert.False(t, zero.IsPresent())
+	assert.Equal(t, "fallback", zero.OrElse("fallback"))
+}
+
+type OptionalSource struct {
+	Nickname gomorph.Optional[string]
+	Age      int
+}
+
+type OptionalDest struct {
+	Nickname string
+	Age      gomorph.Optional[int]
+}
+
+func TestOptional_Converters(t *testing.T) {
+	fieldMappings := []gomorph.FieldMapper{
+		gomorph.From[gomorph.Optional[string], string]("Nickname").
+			To("Nickname").
+			ConvertWith(gomorph.FromOptional("anonymous")).
+			SkipValidation().
+			Build(),
+		gomorph.From[int, gomorph.Optional[int]]("Age").
+			To("Age").
+			ConvertWith(gomorph.ToOptional[int]()).
+			SkipValidation().
+			Build(),
+	}
+	mapper := gomorph.NewStructMapper[OptionalSource, OptionalDest](fieldMappings)
+
+	t.Run("absent optional uses the default", func(t *testing.T) {
+		result, err := mapper.From(OptionalSource{Age: 30})
+		require.NoError(t, err)
+		assert.Equal(t, "anonymous", result.Nickname)
+		assert.Equal(t, gomorph.Some(30), result.Age)
+	})
+
+	t.Run("present optional is unwrapped", func(t *testing.T) {
+		result, err := mapper.From(OptionalSource{Nickname: gomorph.Some("Bob")})
+		require.NoError(t, err)
+		assert.Equal(t, "Bob", result.Nickname)
+	})
+}
+
+type ProfileForm struct {
+	Bio   string
+	Score int
+}
+
+type Profile struct {
+	Bio   *string
+	Score *int
+}
+
+func TestEmptyToNil(t *testing.T) {
+	mapper := gomorph.NewStructMapper[ProfileForm, Profile]([]gomorph.FieldMapper{
+		gomorph.From[string, *string]("Bio").To("Bio").ConvertWith(gomorph.EmptyStringToNil()).SkipValidation().Build(),
+		gomorph.From[int, *int]("Score").To("Score").ConvertWith(gomorph.EmptyToNil[int]()).SkipValidation().Build(),
+	})
+
+	t.Run("empty values become nil", func(t *testing.T) {
+		result, err := mapper.From(ProfileForm{})
+		require.NoError(t, err)
+		assert.Nil(t, result.Bio)
+		assert.Nil(t, result.Score)
+	})
+
+	t.Run("set values become pointers", func(t *testing.T) {
+		result, err := mapper.From(ProfileForm{Bio: "hi", Score: 7})
+		require.NoError(t, err)
+		require.NotNil(t, result.Bio)
+		require.NotNil(t, result.Score)
+		assert.Equal(t, "hi", *result.Bio)
+		assert.Equal(t, 7, *result.Score)
+	})
+}
@@ -0,0 +1,34 @@
+package gomorph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePercent parses percentage strings into fractions (string -> float64), so "42%" becomes
+// 0.42 and "-1.5 %" becomes -0.015. Surrounding whitespace is ignored. When requireSign is true
+// the trailing % is mandatory; otherwise bare numbers such as "42" are read as percentages too.
+func ParsePercent(requireSign bool) TypedMapper {
+	return namedFunc[string, float64]("ParsePercent", func(value string) (float64, error) {
+		trimmed := strings.TrimSpace(value)
+		number, hasSign := strings.CutSuffix(trimmed, "%")
+		if !hasSign && requireSign {
+			return 0, fmt.Errorf("invalid percentage %q: missing %% sign", value)
+		}
+
+		f, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid percentage %q", value)
+		}
+		return f / 100, nil
+	})
+}
+
+// FormatPercent is the reverse of ParsePercent (float64 -> string): the fraction is multiplied by
+// 100 and rendered with the given number of decimals, so 0.42 becomes "42.00%" for decimals 2.
+func FormatPercent(decimals int) TypedMapper {
+	return namedFunc[float64, string]("FormatPercent", func(value float64) (string, error) {
+		return strconv.FormatFloat(value*100, 'f', decimals, 64) + "%", nil
+	})
+}
@@ -0,0 +1,52 @@
+package gomorph_test
+
+import (
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePercent(t *testing.T) {
+	tests := []struct {
+		name        string
+		requireSign bool
+		input       string
+		expected    float64
+		wantErr     string
+	}{
+		{name: "with sign", requireSign: true, input: "42%", expected: 0.42},
+		{name: "whitespace", requireSign: true, input: " -1.5 % ", expected: -0.015},
+		{name: "bare number allowed", input: "42", expected: 0.42},
+		{name: "sign optional", input: "100%", expected: 1},
+		{name: "sign required", requireSign: true, input: "42", wantErr: `invalid percentage "42": missing % sign`},
+		{name: "malformed", input: "abc%", wantErr: `invalid percentage "abc%"`},
+		{name: "empty", input: "", wantErr: `invalid percentage ""`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := gomorph.ParsePercent(tt.requireSign).From(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, result, 1e-12)
+		})
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	result, err := gomorph.FormatPercent(2).From(0.42)
+	require.NoError(t, err)
+	assert.Equal(t, "42.00%", result)
+
+	result, err = gomorph.FormatPercent(0).From(0.125)
+	require.NoError(t, err)
+	assert.Equal(t, "12%", result)
+
+	_, err = gomorph.FormatPercent(2).From("0.42")
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var _ AccessorFieldMapper = (*PresenceMapping)(nil)
+
+// PresenceMapping is a FieldMapper that writes whether a source field is present rather than its
+// value. Create one with PresenceToBool.
+type PresenceMapping struct {
+	from   string
+	target FieldDef[bool]
+}
+
+// PresenceToBool maps the presence of the source field from onto the bool target, for sources
+// where a flag is set by including a key at all ("archived": null still means archived).
+//
+// How presence is determined depends on the source:
+//   - Maps with string keys, including Record: true if the key exists, whatever its value, even nil.
+//     A missing key is false.
+//   - Structs and getters: true if the field or getter value is non-zero. A field that does not
+//     exist is an error, as it can never be present.
+//
+// An Optional field counts as present exactly when IsPresent reports true, since None is the zero
+// Optional; Some of a zero value is still present.
+//
+// Example:
+//
+//	gomorph.PresenceToBool("archived", gomorph.NewField[bool]("Archived"))
+func PresenceToBool(from string, target FieldDef[bool]) *PresenceMapping {
+	return &PresenceMapping{from: from, target: target}
+}
+
+func (m *PresenceMapping) From() Field {
+	return fieldInfo{name: m.from, typ: reflect.TypeFor[bool]()}
+}
+
+func (m *PresenceMapping) To() Field {
+	return m.target
+}
+
+// SourceAccessor reads the presence of the field instead of its value.
+func (m *PresenceMapping) SourceAccessor() SourceAccessor {
+	return func(source any) (any, error) {
+		val := reflect.ValueOf(source)
+		if val.Kind() == reflect.Ptr {
+			val = val.Elem()
+		}
+		if val.Kind() == reflect.Map && val.Type().Key().Kind() == reflect.String {
+			_, ok := readMapKey(source, m.from)
+			return ok, nil
+		}
+
+		value, err := getFieldValueByName(source, m.from)
+		if err != nil {
+			return nil, err
+		}
+		return value != nil && !reflect.ValueOf(value).IsZero(), nil
+	}
+}
+
+// Map expects the bool produced by SourceAccessor.
+func (m *PresenceMapping) Map(value any) (FieldMappingResult, error) {
+	present, ok := value.(bool)
+	if !ok {
+		return NewFieldMappingResult(m.target, NewTypedValue(nil)),
+			fmt.Errorf("invalid source type: expected bool, got %T", value)
+	}
+	return NewFieldMappingResult(m.target, NewTypedValue(present)), nil
+}
@@ -0,0 +1,75 @@
+package gomorph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type FlagDest struct {
+	Archived bool
+}
+
+type FlagSource struct {
+	ArchivedAt string
+	Note       gomorph.Optional[string]
+}
+
+func TestPresenceToBool_Record(t *testing.T) {
+	mapper := gomorph.NewStructMapper[gomorph.Record, FlagDest]([]gomorph.FieldMapper{
+		gomorph.PresenceToBool("archived", gomorph.NewField[bool]("Archived")),
+	})
+
+	tests := []struct {
+		name   string
+		source gomorph.Record
+		want   bool
+	}{
+		{name: "key with value", source: gomorph.Record{"archived": "yes"}, want: true},
+		{name: "key with false value", source: gomorph.Record{"archived": false}, want: true},
+		{name: "key with nil value", source: gomorph.Record{"archived": nil}, want: true},
+		{name: "missing key", source: gomorph.Record{"other": 1}, want: false},
+		{name: "nil record", source: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mapper.From(tt.source)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result.Archived)
+		})
+	}
+}
+
+func TestPresenceToBool_Struct(t *testing.T) {
+	byField := gomorph.NewStructMapper[FlagSource, FlagDest]([]gomorph.FieldMapper{
+		gomorph.PresenceToBool("ArchivedAt", gomorph.NewField[bool]("Archived")),
+	})
+	result, err := byField.From(FlagSource{ArchivedAt: "2024-01-01"})
+	require.NoError(t, err)
+	assert.True(t, result.Archived)
+
+	result, err = byField.From(FlagSource{})
+	require.NoError(t, err)
+	assert.False(t, result.Archived, "zero struct fields are absent")
+
+	byOptional := gomorph.NewStructMapper[FlagSource, FlagDest]([]gomorph.FieldMapper{
+		gomorph.PresenceToBool("Note", gomorph.NewField[bool]("Archived")),
+	})
+	result, err = byOptional.From(FlagSource{Note: gomorph.Some("")})
+	require.NoError(t, err)
+	assert.True(t, result.Archived, "Some of a zero value is present")
+
+	result, err = byOptional.From(FlagSource{Note: gomorph.None[string]()})
+	require.NoError(t, err)
+	assert.False(t, result.Archived)
+
+	missing := gomorph.NewStructMapper[FlagSource, FlagDest]([]gomorph.FieldMapper{
+		gomorph.PresenceToBool("Nope", gomorph.NewField[bool]("Archived")),
+	})
+	_, err = missing.From(FlagSource{})
+	assert.True(t, errors.Is(err, gomorph.ErrFieldNotFound))
+}
@@ -0,0 +1,243 @@
+package gomorph
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LowercaseKeysDeep returns a copy of a Record with every key lowercased, recursing into nested
+// Records and into Records held in []any slices (Record -> Record). The input is not modified.
+//
+// When lowercasing makes two keys collide ("ID" and "id"), keys are applied in sorted order and
+// the last one wins, so the result is deterministic: "id" (which sorts after "ID") is kept. Use
+// LowercaseKeysDeepStrict to reject collisions instead.
+func LowercaseKeysDeep() TypedMapper {
+	return namedFunc[Record, Record]("LowercaseKeysDeep", func(record Record) (Record, error) {
+		return lowercaseRecord(record, "", false)
+	})
+}
+
+// LowercaseKeysDeepStrict behaves like LowercaseKeysDeep but returns an error naming the
+// colliding keys and their location instead of letting one of them win.
+func LowercaseKeysDeepStrict() TypedMapper {
+	return namedFunc[Record, Record]("LowercaseKeysDeepStrict", func(record Record) (Record, error) {
+		return lowercaseRecord(record, "", true)
+	})
+}
+
+func lowercaseRecord(record Record, path string, strict bool) (Record, error) {
+	if record == nil {
+		return nil, nil
+	}
+
+	keys := sortedKeys(record)
+
+	result := make(Record, len(record))
+	origins := make(map[string]string, len(record))
+	for _, key := range keys {
+		lowered := strings.ToLower(key)
+		if previous, exists := origins[lowered]; exists && strict {
+			return nil, fmt.Errorf("key collision at %q: %q and %q both lowercase to %q", pathOrRoot(path), previous, key, lowered)
+		}
+
+		value, err := lowercaseValue(record[key], joinPath(path, lowered), strict)
+		if err != nil {
+			return nil, err
+		}
+		origins[lowered] = key
+		result[lowered] = value
+	}
+	return result, nil
+}
+
+func lowercaseValue(value any, path string, strict bool) (any, error) {
+	switch v := value.(type) {
+	case Record:
+		return lowercaseRecord(v, path, strict)
+	case []any:
+		if v == nil {
+			return v, nil
+		}
+		items := make([]any, len(v))
+		for i, item := range v {
+			lowered, err := lowercaseValue(item, fmt.Sprintf("%s[%d]", path, i), strict)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = lowered
+		}
+		return items, nil
+	default:
+		return value, nil
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "<root>"
+	}
+	return path
+}
+
+// RecordMapper applies a converter to each configured key of a Record, for reshaping documents
+// that stay maps rather than becoming structs. Keys without a converter are copied unchanged
+// and the input is never modified.
+type RecordMapper struct {
+	converters map[string]TypedMapper
+}
+
+func NewRecordMapper(keyConverters map[string]TypedMapper) *RecordMapper {
+	converters := make(map[string]TypedMapper, len(keyConverters))
+	for key, converter := range keyConverters {
+		converters[key] = converter
+	}
+	return &RecordMapper{converters: converters}
+}
+
+// From converts every configured key present in record. All failing keys are reported together
+// as KeyErrors.
+func (m *RecordMapper) From(record Record) (Record, error) {
+	if record == nil {
+		return nil, nil
+	}
+
+	result := make(Record, len(record))
+	errs := KeyErrors{}
+	for key, value := range record {
+		converter, ok := m.converters[key]
+		if !ok {
+			result[key] = value
+			continue
+		}
+		converted, err := converter.From(value)
+		if err != nil {
+			errs[key] = err
+			continue
+		}
+		result[key] = converted
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return result, nil
+}
+
+// CoalesceKeys reads the first of keys that is present on the source with a non-nil value, for
+// sources that name the same value differently across versions ("zip" vs "postal_code"). Keys are
+// looked up with the default accessors, so struct fields and getters work as well as Record keys;
+// the StructMapper's WithAccessors configuration does not apply. It is used with the builder's
+// ReadWith step. A key that is not found, or holds nil, moves on to the next key; any other
+// lookup error, such as ErrAmbiguousField, is returned as-is. When none of the keys yields a value
+// the error lists every key tried and matches ErrFieldNotFound.
+func CoalesceKeys(keys ...string) SourceAccessor {
+	return func(source any) (any, error) {
+		for _, key := range keys {
+			value, err := getFieldValueByName(source, key)
+			if err != nil && !errors.Is(err, ErrFieldNotFound) {
+				return nil, err
+			}
+			if err == nil && value != nil {
+				return value, nil
+			}
+		}
+		return nil, fmt.Errorf("none of the keys %q present on %T: %w", keys, source, ErrFieldNotFound)
+	}
+}
+
+// NestKeys expands delimited keys into nested Records (Record -> Record), so with sep "." the
+// record {"a.b.c": 1, "a.d": 2} becomes {"a": {"b": {"c": 1}, "d": 2}}. Values are never merged:
+// a key that is both a value and the parent of another key, such as "a" next to "a.b", is a
+// conflict and reported with both keys. The input is not modified.
+func NestKeys(sep string) TypedMapper {
+	return namedFunc[Record, Record]("NestKeys", func(record Record) (Record, error) {
+		if record == nil {
+			return nil, nil
+		}
+
+		result := make(Record, len(record))
+		leaves := make(map[string]string, len(record))
+		branches := make(map[string]string)
+		for _, key := range sortedKeys(record) {
+			parts := strings.Split(key, sep)
+			node := result
+			for i, part := range parts[:len(parts)-1] {
+				prefix := strings.Join(parts[:i+1], sep)
+				if leaf, ok := leaves[prefix]; ok {
+					return nil, nestConflict(prefix, leaf, key)
+				}
+				if _, ok := branches[prefix]; !ok {
+					branches[prefix] = key
+					node[part] = Record{}
+				}
+				node = node[part].(Record)
+			}
+			if branch, ok := branches[key]; ok {
+				return nil, nestConflict(key, key, branch)
+			}
+			leaves[key] = key
+			node[parts[len(parts)-1]] = record[key]
+		}
+		return result, nil
+	})
+}
+
+func nestConflict(path, leaf, branch string) error {
+	return fmt.Errorf("key conflict at %q: %q is a value but %q needs it to be a parent", path, leaf, branch)
+}
+
+// FlattenKeys is the reverse of NestKeys (Record -> Record): nested Records are collapsed into
+// keys joined with sep, so {"a": {"b": 1}} becomes {"a.b": 1}. Slices and empty Records are kept
+// as values. Two paths that flatten to the same key, such as "a.b" next to {"a": {"b": ...}},
+// are reported as a conflict.
+func FlattenKeys(sep string) TypedMapper {
+	return namedFunc[Record, Record]("FlattenKeys", func(record Record) (Record, error) {
+		if record == nil {
+			return nil, nil
+		}
+		result := make(Record, len(record))
+		if err := flattenInto(result, record, "", sep); err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+func flattenInto(result, record Record, prefix, sep string) error {
+	for _, key := range sortedKeys(record) {
+		flatKey := key
+		if prefix != "" {
+			flatKey = prefix + sep + key
+		}
+
+		if nested, ok := record[key].(Record); ok && len(nested) > 0 {
+			if err := flattenInto(result, nested, flatKey, sep); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, exists := result[flatKey]; exists {
+			return fmt.Errorf("key conflict: more than one value flattens to %q", flatKey)
+		}
+		result[flatKey] = record[key]
+	}
+	return nil
+}
+
+func sortedKeys(record Record) []string {
+	keys := make([]string, 0, len(record))
+	for key := range record {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,188 @@
+package gomorph_test
+
+import (
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLowercaseKeysDeep(t *testing.T) {
+	input := gomorph.Record{
+		"Name": "Gimli",
+		"Stats": gomorph.Record{
+			"HP":    85,
+			"Level": 12,
+		},
+		"Items": []any{
+			gomorph.Record{"Kind": "axe"},
+			"plain value",
+		},
+	}
+
+	got, err := gomorph.LowercaseKeysDeep().From(input)
+	require.NoError(t, err)
+	assert.Equal(t, gomorph.Record{
+		"name": "Gimli",
+		"stats": gomorph.Record{
+			"hp":    85,
+			"level": 12,
+		},
+		"items": []any{
+			gomorph.Record{"kind": "axe"},
+			"plain value",
+		},
+	}, got)
+
+	assert.Contains(t, input, "Name", "the input is left untouched")
+	assert.Contains(t, input["Stats"], "HP")
+}
+
+func TestLowercaseKeysDeep_Collisions(t *testing.T) {
+	input := gomorph.Record{
+		"Nested": gomorph.Record{"ID": 1, "id": 2},
+	}
+
+	got, err := gomorph.LowercaseKeysDeep().From(input)
+	require.NoError(t, err)
+	assert.Equal(t, gomorph.Record{"nested": gomorph.Record{"id": 2}}, got, "last key in sorted order wins")
+
+	_, err = gomorph.LowercaseKeysDeepStrict().From(input)
+	assert.EqualError(t, err, `key collision at "nested": "ID" and "id" both lowercase to "id"`)
+
+	_, err = gomorph.LowercaseKeysDeepStrict().From(gomorph.Record{
+		"list": []any{gomorph.Record{"A": 1, "a": 2}},
+	})
+	assert.EqualError(t, err, `key collision at "list[0]": "A" and "a" both lowercase to "a"`)
+}
+
+func TestRecordMapper(t *testing.T) {
+	mapper := gomorph.NewRecordMapper(map[string]gomorph.TypedMapper{
+		"name":  StringToIntMapper{},
+		"level": IntDoubler{},
+	})
+
+	input := gomorph.Record{"name": "Gandalf", "level": 10, "class": "Wizard"}
+	result, err := mapper.From(input)
+	require.NoError(t, err)
+	assert.Equal(t, gomorph.Record{"name": 7, "level": 20, "class": "Wizard"}, result)
+	assert.Equal(t, "Gandalf", input["name"], "input is not modified")
+
+	result, err = mapper.From(gomorph.Record{"class": "Rogue"})
+	require.NoError(t, err)
+	assert.Equal(t, gomorph.Record{"class": "Rogue"}, result, "missing keys are not converted")
+
+	_, err = mapper.From(gomorph.Record{"name": 1, "level": "high"})
+	var keyErrs gomorph.KeyErrors
+	require.ErrorAs(t, err, &keyErrs)
+	assert.Len(t, keyErrs, 2)
+	assert.EqualError(t, err, "2 key(s) failed: level: expected int, got string; name: expected string, got int")
+}
+
+type PostalAddress struct {
+	PostalCode string
+}
+
+func TestCoalesceKeys(t *testing.T) {
+	mapper := gomorph.NewStructMapper[gomorph.Record, PostalAddress]([]gomorph.FieldMapper{
+		gomorph.From[any, string]("zip").To("PostalCode").
+			ConvertWith(gomorph.AssertType[string]()).
+			SkipValidation().
+			ReadWith(gomorph.CoalesceKeys("zip", "postal_code")).
+			Build(),
+	})
+
+	tests := []struct {
+		name     string
+		input    gomorph.Record
+		expected string
+	}{
+		{name: "first key", input: gomorph.Record{"zip": "10115", "postal_code": "ignored"}, expected: "10115"},
+		{name: "fallback key", input: gomorph.Record{"postal_code": "SW1A"}, expected: "SW1A"},
+		{name: "nil is skipped", input: gomorph.Record{"zip": nil, "postal_code": "75001"}, expected: "75001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mapper.From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.PostalCode)
+		})
+	}
+
+	_, err := mapper.From(gomorph.Record{"code": "x"})
+	assert.ErrorIs(t, err, gomorph.ErrFieldNotFound)
+	assert.EqualError(t, err, `input error [zip]: none of the keys ["zip" "postal_code"] present on map[string]interface {}: field not found`)
+}
+
+func TestCoalesceKeys_ReportsLookupErrors(t *testing.T) {
+	read := gomorph.CoalesceKeys("ID", "CreatedBy")
+
+	_, err := read(AmbiguousAccount{})
+	assert.ErrorIs(t, err, gomorph.ErrAmbiguousField, "an ambiguous key is not skipped")
+
+	value, err := read(Account{BaseModel: BaseModel{ID: 7}})
+	require.NoError(t, err)
+	assert.Equal(t, 7, value)
+}
+
+func TestNestKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    gomorph.Record
+		expected gomorph.Record
+		wantErr  string
+	}{
+		{
+			name:  "nests dotted keys",
+			input: gomorph.Record{"a.b.c": 1, "a.d": 2, "e": 3},
+			expected: gomorph.Record{
+				"a": gomorph.Record{"b": gomorph.Record{"c": 1}, "d": 2},
+				"e": 3,
+			},
+		},
+		{name: "nil", input: nil, expected: nil},
+		{
+			name:    "leaf and branch",
+			input:   gomorph.Record{"a": 1, "a.b": 2},
+			wantErr: `key conflict at "a": "a" is a value but "a.b" needs it to be a parent`,
+		},
+		{
+			name:    "deep leaf and branch",
+			input:   gomorph.Record{"a.b": 1, "a.b.c": 2},
+			wantErr: `key conflict at "a.b": "a.b" is a value but "a.b.c" needs it to be a parent`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomorph.NestKeys(".").From(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestFlattenKeys(t *testing.T) {
+	nested := gomorph.Record{
+		"a":    gomorph.Record{"b": gomorph.Record{"c": 1}, "d": 2},
+		"tags": []any{"x", "y"},
+		"meta": gomorph.Record{},
+	}
+	flat, err := gomorph.FlattenKeys(".").From(nested)
+	require.NoError(t, err)
+	assert.Equal(t, gomorph.Record{"a.b.c": 1, "a.d": 2, "tags": []any{"x", "y"}, "meta": gomorph.Record{}}, flat)
+
+	_, err = gomorph.FlattenKeys(".").From(gomorph.Record{"a.b": 1, "a": gomorph.Record{"b": 2}})
+	assert.EqualError(t, err, `key conflict: more than one value flattens to "a.b"`)
+
+	roundTrip, err := gomorph.NestKeys("/").From(gomorph.Record{"x/y": 1, "x/z": 2})
+	require.NoError(t, err)
+	back, err := gomorph.FlattenKeys("/").From(roundTrip)
+	require.NoError(t, err)
+	assert.Equal(t, gomorph.Record{"x/y": 1, "x/z": 2}, back)
+}
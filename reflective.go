@@ -0,0 +1,203 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ReflectiveOption configures ReflectiveStructMapper.
+type ReflectiveOption func(*reflectiveConfig)
+
+type reflectiveConfig struct {
+	registry      *ConverterRegistry
+	strict        bool
+	fieldMappings []FieldMapper
+	structOptions []StructOption
+}
+
+// WithDefaultConverters lets ReflectiveStructMapper bridge name-matched fields whose types are
+// not assignable, using the first converter in reg from the source field type to the destination
+// field type.
+func WithDefaultConverters(reg *ConverterRegistry) ReflectiveOption {
+	return func(c *reflectiveConfig) {
+		c.registry = reg
+	}
+}
+
+// StrictFields makes ReflectiveStructMapper fail when a destination field cannot be filled,
+// instead of leaving it at its zero value.
+func StrictFields() ReflectiveOption {
+	return func(c *reflectiveConfig) {
+		c.strict = true
+	}
+}
+
+// WithFieldMappings supplies explicit mappings for fields that matching by name cannot handle.
+// Their target fields are excluded from matching and are mapped after the matched fields.
+func WithFieldMappings(mappings ...FieldMapper) ReflectiveOption {
+	return func(c *reflectiveConfig) {
+		c.fieldMappings = append(c.fieldMappings, mappings...)
+	}
+}
+
+// WithStructOptions passes opts on to the underlying StructMapper.
+func WithStructOptions(opts ...StructOption) ReflectiveOption {
+	return func(c *reflectiveConfig) {
+		c.structOptions = append(c.structOptions, opts...)
+	}
+}
+
+// ReflectiveStructMapper builds a StructMapper between two struct types without explicit field
+// mappings: every exported field of TDest is filled from the TSource field or zero-argument
+// getter of the same name. Values whose types are assignable, or convertible within the same
+// kind, are copied; other type pairs need a converter from WithDefaultConverters. Fields with no
+// usable match are left at their zero value, or reported as an error with StrictFields. Use
+// WithFieldMappings for the fields that need more than a copy.
+//
+// Example:
+//
+//	mapper, err := gomorph.ReflectiveStructMapper[UserRow, User](
+//	    gomorph.WithDefaultConverters(registry),
+//	    gomorph.WithFieldMappings(fullName),
+//	)
+func ReflectiveStructMapper[TSource, TDest any](opts ...ReflectiveOption) (StructMapper[TSource, TDest], error) {
+	var config reflectiveConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	sourceType := reflect.TypeFor[TSource]()
+	destType := reflect.TypeFor[TDest]()
+	if structBase(sourceType).Kind() != reflect.Struct || destType.Kind() != reflect.Struct {
+		return StructMapper[TSource, TDest]{},
+			fmt.Errorf("ReflectiveStructMapper: %v and %v must both be structs", sourceType, destType)
+	}
+
+	explicit := make(map[string]bool, len(config.fieldMappings))
+	for _, mapping := range config.fieldMappings {
+		explicit[mapping.To().Name()] = true
+	}
+
+	var mappings []FieldMapper
+	for _, field := range reflect.VisibleFields(destType) {
+		if !field.IsExported() || field.Anonymous || explicit[field.Name] {
+			continue
+		}
+
+		mapping, err := reflectiveFieldMapping(sourceType, field, config.registry)
+		if err != nil {
+			if config.strict {
+				return StructMapper[TSource, TDest]{}, fmt.Errorf("ReflectiveStructMapper: %w", err)
+			}
+			continue
+		}
+		mappings = append(mappings, mapping)
+	}
+	mappings = append(mappings, config.fieldMappings...)
+
+	return NewStructMapper[TSource, TDest](mappings, config.structOptions...), nil
+}
+
+func reflectiveFieldMapping(sourceType reflect.Type, field reflect.StructField, reg *ConverterRegistry) (FieldMapper, error) {
+	fromType, ok := sourceFieldType(sourceType, field.Name)
+	if !ok {
+		return nil, fmt.Errorf("destination field %q has no matching source field on %v", field.Name, sourceType)
+	}
+
+	mapping := specFieldMapping{
+		from: fieldInfo{name: field.Name, typ: fromType},
+		to:   fieldInfo{name: field.Name, typ: field.Type},
+	}
+	if specAssignable(fromType, field.Type) {
+		return mapping, nil
+	}
+	if reg != nil {
+		if converter, ok := reg.Find(fromType, field.Type); ok {
+			mapping.mappers = []TypedMapper{converter}
+			return mapping, nil
+		}
+	}
+	return nil, fmt.Errorf("field %q: no converter from %v to %v", field.Name, fromType, field.Type)
+}
+
+// structBase returns the struct type behind a pointer type.
+func structBase(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+// NewStructMapperFromTags builds a StructMapper from `gomorph:"TargetField"` tags on the fields
+// of the TSource struct: each tagged field is copied to the named TDest field. Untagged fields and
+// fields tagged "-" are skipped. A tag naming a destination field that does not exist, or one
+// whose type the source value cannot be assigned to, is reported as an error; use explicit
+// FieldMappings for fields that need conversion.
+//
+// Example:
+//
+//	type UserRow struct {
+//	    FullName string `gomorph:"Name"`
+//	    Mail     string `gomorph:"Email"`
+//	}
+//
+//	mapper, err := gomorph.NewStructMapperFromTags[UserRow, User]()
+func NewStructMapperFromTags[TSource, TDest any](opts ...StructOption) (StructMapper[TSource, TDest], error) {
+	sourceType := reflect.TypeFor[TSource]()
+	destType := reflect.TypeFor[TDest]()
+	if structBase(sourceType).Kind() != reflect.Struct {
+		return StructMapper[TSource, TDest]{}, fmt.Errorf("NewStructMapperFromTags: %v is not a struct", sourceType)
+	}
+
+	var mappings []FieldMapper
+	for _, field := range reflect.VisibleFields(structBase(sourceType)) {
+		target := field.Tag.Get("gomorph")
+		if target == "" || target == "-" || !field.IsExported() {
+			continue
+		}
+
+		toType, ok := destFieldType(destType, target)
+		if !ok {
+			return StructMapper[TSource, TDest]{},
+				fmt.Errorf("NewStructMapperFromTags: field %q is tagged for %q, which is not a field of %v", field.Name, target, destType)
+		}
+		if !specAssignable(field.Type, toType) {
+			return StructMapper[TSource, TDest]{},
+				fmt.Errorf("NewStructMapperFromTags: field %q is %v, which cannot be assigned to %q of type %v", field.Name, field.Type, target, toType)
+		}
+		mappings = append(mappings, specFieldMapping{
+			from: fieldInfo{name: field.Name, typ: field.Type},
+			to:   fieldInfo{name: target, typ: toType},
+		})
+	}
+
+	return NewStructMapper[TSource, TDest](mappings, opts...), nil
+}
+
+// AutoFields returns a copying FieldMapper for every exported TDest field whose name matches a
+// TSource field or zero-argument getter with an assignable type. Unmatched fields are omitted, so
+// the result can be extended with mappings for the fields that differ. It panics if either type
+// is not a struct.
+//
+// Example:
+//
+//	mappings := append(gomorph.AutoFields[UserRow, User](), fullName)
+//	mapper := gomorph.NewStructMapper[UserRow, User](mappings)
+func AutoFields[TSource, TDest any]() []FieldMapper {
+	sourceType := reflect.TypeFor[TSource]()
+	destType := reflect.TypeFor[TDest]()
+	if structBase(sourceType).Kind() != reflect.Struct || destType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("AutoFields: %v and %v must both be structs", sourceType, destType))
+	}
+
+	var mappings []FieldMapper
+	for _, field := range reflect.VisibleFields(destType) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		if mapping, err := reflectiveFieldMapping(sourceType, field, nil); err == nil {
+			mappings = append(mappings, mapping)
+		}
+	}
+	return mappings
+}
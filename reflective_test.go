@@ -0,0 +1,151 @@
+package gomorph_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type UserRow struct {
+	ID       string
+	Name     string
+	Age      int32
+	Nickname string
+	internal string
+}
+
+func (r UserRow) DisplayName() string {
+	return "@" + r.Nickname
+}
+
+// AtoiMapper parses decimal strings into ints.
+type AtoiMapper struct {
+	gomorph.TypeMap[string, int]
+}
+
+func (AtoiMapper) From(source any) (any, error) {
+	return strconv.Atoi(source.(string))
+}
+
+type UserLabel string
+
+type User struct {
+	ID          int
+	Name        UserLabel
+	Age         int32
+	DisplayName string
+	Email       string
+}
+
+func TestReflectiveStructMapper(t *testing.T) {
+	registry := gomorph.NewConverterRegistry()
+	registry.MustRegister("atoi", AtoiMapper{})
+
+	mapper, err := gomorph.ReflectiveStructMapper[UserRow, User](gomorph.WithDefaultConverters(registry))
+	require.NoError(t, err)
+
+	result, err := mapper.From(UserRow{ID: "42", Name: "Ada", Age: 36, Nickname: "ada"})
+	require.NoError(t, err)
+	assert.Equal(t, User{ID: 42, Name: "Ada", Age: 36, DisplayName: "@ada"}, result,
+		"Email has no source and is left zero")
+
+	_, err = mapper.From(UserRow{ID: "x"})
+	assert.ErrorContains(t, err, "mapping error [ID]")
+}
+
+func TestReflectiveStructMapper_Strict(t *testing.T) {
+	_, err := gomorph.ReflectiveStructMapper[UserRow, User](gomorph.StrictFields())
+	assert.EqualError(t, err, `ReflectiveStructMapper: field "ID": no converter from string to int`)
+
+	registry := gomorph.NewConverterRegistry()
+	registry.MustRegister("atoi", AtoiMapper{})
+	_, err = gomorph.ReflectiveStructMapper[UserRow, User](gomorph.StrictFields(), gomorph.WithDefaultConverters(registry))
+	assert.EqualError(t, err, `ReflectiveStructMapper: destination field "Email" has no matching source field on gomorph_test.UserRow`)
+
+	email := gomorph.From[string, string]("Nickname").
+		To("Email").
+		ConvertWith(gomorph.MustReplaceAllRegex([]gomorph.RegexReplacement{{Pattern: "$", Repl: "@example.com"}})).
+		SkipValidation().
+		Build()
+	mapper, err := gomorph.ReflectiveStructMapper[UserRow, User](
+		gomorph.StrictFields(),
+		gomorph.WithDefaultConverters(registry),
+		gomorph.WithFieldMappings(email),
+	)
+	require.NoError(t, err)
+
+	result, err := mapper.From(UserRow{ID: "1", Nickname: "ada"})
+	require.NoError(t, err)
+	assert.Equal(t, "ada@example.com", result.Email)
+}
+
+func TestReflectiveStructMapper_RequiresStructs(t *testing.T) {
+	_, err := gomorph.ReflectiveStructMapper[map[string]any, User]()
+	assert.EqualError(t, err, "ReflectiveStructMapper: map[string]interface {} and gomorph_test.User must both be structs")
+}
+
+type TaggedRow struct {
+	FullName string `gomorph:"Name"`
+	Years    int32  `gomorph:"Age"`
+	Mail     string `gomorph:"Email"`
+	Internal string `gomorph:"-"`
+	Untagged string
+}
+
+func TestNewStructMapperFromTags(t *testing.T) {
+	mapper, err := gomorph.NewStructMapperFromTags[TaggedRow, User]()
+	require.NoError(t, err)
+
+	result, err := mapper.From(TaggedRow{FullName: "Ada", Years: 36, Mail: "ada@example.com", Internal: "x", Untagged: "y"})
+	require.NoError(t, err)
+	assert.Equal(t, User{Name: "Ada", Age: 36, Email: "ada@example.com"}, result)
+
+	descriptions := mapper.Describe()
+	require.Len(t, descriptions, 3)
+	assert.Equal(t, "FullName", descriptions[0].From)
+	assert.Equal(t, "Name", descriptions[0].To)
+}
+
+func TestNewStructMapperFromTags_Errors(t *testing.T) {
+	type unknownTarget struct {
+		A string `gomorph:"Nope"`
+	}
+	_, err := gomorph.NewStructMapperFromTags[unknownTarget, User]()
+	assert.EqualError(t, err, `NewStructMapperFromTags: field "A" is tagged for "Nope", which is not a field of gomorph_test.User`)
+
+	type wrongType struct {
+		ID string `gomorph:"ID"`
+	}
+	_, err = gomorph.NewStructMapperFromTags[wrongType, User]()
+	assert.EqualError(t, err, `NewStructMapperFromTags: field "ID" is string, which cannot be assigned to "ID" of type int`)
+}
+
+func TestAutoFields(t *testing.T) {
+	mappings := gomorph.AutoFields[UserRow, User]()
+
+	var targets []string
+	for _, mapping := range mappings {
+		targets = append(targets, mapping.To().Name())
+	}
+	assert.Equal(t, []string{"Name", "Age", "DisplayName"}, targets, "ID has no assignable source and Email no source at all")
+
+	mappings = append(mappings, gomorph.NewFieldMapping(
+		gomorph.NewField[string]("ID"),
+		gomorph.NewField[int]("ID"),
+		gomorph.NewChainedMapper[string, int](AtoiMapper{}),
+	))
+	mapper := gomorph.NewStructMapper[UserRow, User](mappings)
+
+	result, err := mapper.From(UserRow{ID: "7", Name: "ada", Age: 36, Nickname: "countess"})
+	require.NoError(t, err)
+	assert.Equal(t, User{ID: 7, Name: "ada", Age: 36, DisplayName: "@countess"}, result)
+}
+
+func TestAutoFields_NotStruct(t *testing.T) {
+	assert.PanicsWithValue(t, "AutoFields: string and gomorph_test.User must both be structs", func() {
+		gomorph.AutoFields[string, User]()
+	})
+}
@@ -0,0 +1,106 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ConverterEntry describes a single conversion registered in a ConverterRegistry.
+type ConverterEntry struct {
+	Name string
+	From reflect.Type
+	To   reflect.Type
+}
+
+// ConverterRegistry holds named TypedMappers so mappings can refer to converters by name, for
+// example when they are assembled from configuration. It is safe for concurrent use.
+type ConverterRegistry struct {
+	mu         sync.RWMutex
+	names      []string
+	converters map[string]TypedMapper
+}
+
+func NewConverterRegistry() *ConverterRegistry {
+	return &ConverterRegistry{
+		converters: make(map[string]TypedMapper),
+	}
+}
+
+// Register adds converter under name. Names must be unique within a registry.
+func (r *ConverterRegistry) Register(name string, converter TypedMapper) error {
+	if name == "" {
+		return fmt.Errorf("converter name must not be empty")
+	}
+	if converter == nil {
+		return fmt.Errorf("converter %q must not be nil", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.converters[name]; exists {
+		return fmt.Errorf("converter %q is already registered", name)
+	}
+	r.names = append(r.names, name)
+	r.converters[name] = converter
+	return nil
+}
+
+// MustRegister is like Register but panics on error. It is intended for package-level setup.
+func (r *ConverterRegistry) MustRegister(name string, converter TypedMapper) {
+	if err := r.Register(name, converter); err != nil {
+		panic(err.Error())
+	}
+}
+
+// Lookup returns the converter registered under name.
+func (r *ConverterRegistry) Lookup(name string) (TypedMapper, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	converter, ok := r.converters[name]
+	return converter, ok
+}
+
+// Find returns the first registered converter, in registration order, that converts from into to.
+func (r *ConverterRegistry) Find(from, to reflect.Type) (TypedMapper, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, name := range r.names {
+		converter := r.converters[name]
+		if converter.SourceType() == from && converter.TargetType() == to {
+			return converter, true
+		}
+	}
+	return nil, false
+}
+
+// Entries returns a snapshot of the registered conversions in registration order. The returned
+// slice is a copy; later registrations do not affect it.
+func (r *ConverterRegistry) Entries() []ConverterEntry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]ConverterEntry, 0, len(r.names))
+	for _, name := range r.names {
+		converter := r.converters[name]
+		entries = append(entries, ConverterEntry{
+			Name: name,
+			From: converter.SourceType(),
+			To:   converter.TargetType(),
+		})
+	}
+	return entries
+}
+
+// String dumps the registered conversions, one "name: From -> To" line per entry.
+func (r *ConverterRegistry) String() string {
+	var b strings.Builder
+	for _, entry := range r.Entries() {
+		fmt.Fprintf(&b, "%s: %v -> %v\n", entry.Name, entry.From, entry.To)
+	}
+	return b.String()
+}
@@ -0,0 +1,66 @@
+package gomorph_test
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverterRegistry_RegisterAndLookup(t *testing.T) {
+	registry := gomorph.NewConverterRegistry()
+	require.NoError(t, registry.Register("length", StringToIntMapper{}))
+	require.NoError(t, registry.Register("double", IntDoubler{}))
+
+	converter, ok := registry.Lookup("length")
+	require.True(t, ok)
+	assert.Equal(t, StringToIntMapper{}, converter)
+
+	_, ok = registry.Lookup("missing")
+	assert.False(t, ok)
+
+	found, ok := registry.Find(reflect.TypeOf(0), reflect.TypeOf(0))
+	require.True(t, ok)
+	assert.Equal(t, IntDoubler{}, found)
+
+	assert.EqualError(t, registry.Register("length", IntDoubler{}), `converter "length" is already registered`)
+	assert.EqualError(t, registry.Register("", IntDoubler{}), "converter name must not be empty")
+}
+
+func TestConverterRegistry_EntriesAndString(t *testing.T) {
+	registry := gomorph.NewConverterRegistry()
+	registry.MustRegister("length", StringToIntMapper{})
+	registry.MustRegister("double", IntDoubler{})
+
+	entries := registry.Entries()
+	assert.Equal(t, []gomorph.ConverterEntry{
+		{Name: "length", From: reflect.TypeOf(""), To: reflect.TypeOf(0)},
+		{Name: "double", From: reflect.TypeOf(0), To: reflect.TypeOf(0)},
+	}, entries)
+
+	registry.MustRegister("upper", UppercaseMapper{})
+	assert.Len(t, entries, 2, "entries are a snapshot")
+
+	assert.Equal(t, "length: string -> int\ndouble: int -> int\nupper: string -> string\n", registry.String())
+}
+
+func TestConverterRegistry_ConcurrentRegistration(t *testing.T) {
+	registry := gomorph.NewConverterRegistry()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			registry.MustRegister(fmt.Sprintf("double-%d", i), IntDoubler{})
+			_ = registry.Entries()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Len(t, registry.Entries(), 50)
+}
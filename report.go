@@ -0,0 +1,67 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FieldStatus describes what happened to a field during mapping.
+type FieldStatus int
+
+const (
+	// FieldMapped is reported for values produced by FieldMappers that give no further detail.
+	FieldMapped FieldStatus = iota
+	// FieldCopied means the value was assigned unchanged, without running any step.
+	FieldCopied
+	// FieldConverted means the value went through at least one converter.
+	FieldConverted
+	// FieldValidated means the value was only checked by validators and assigned unchanged.
+	FieldValidated
+	// FieldSkipped means a step returned ErrSkipField, or an optional source field was missing, and
+	// the target was not written.
+	FieldSkipped
+	// FieldFailed means reading, mapping or assigning the field failed.
+	FieldFailed
+	// FieldDefaulted means the source field was missing and the mapping's default was assigned.
+	FieldDefaulted
+)
+
+func (s FieldStatus) String() string {
+	switch s {
+	case FieldMapped:
+		return "mapped"
+	case FieldCopied:
+		return "copied"
+	case FieldConverted:
+		return "converted"
+	case FieldValidated:
+		return "validated"
+	case FieldSkipped:
+		return "skipped"
+	case FieldFailed:
+		return "failed"
+	case FieldDefaulted:
+		return "defaulted"
+	default:
+		return fmt.Sprintf("FieldStatus(%d)", int(s))
+	}
+}
+
+// FieldReport records how a single target field was produced by StructMapper.FromVerbose. Steps
+// names the chain steps that were applied, in order. Err is set for failed fields.
+type FieldReport struct {
+	From   string
+	To     string
+	Status FieldStatus
+	Steps  []string
+	Err    error
+}
+
+// stepName returns a human-readable name for a chain step: its Name when it implements Named,
+// its type name otherwise.
+func stepName(m TypedMapper) string {
+	if named, ok := m.(Named); ok {
+		return named.Name()
+	}
+	return reflect.TypeOf(m).String()
+}
@@ -0,0 +1,177 @@
+package gomorph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SemVer is a parsed semantic version as defined by https://semver.org.
+type SemVer struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease string
+	Build      string
+}
+
+// String formats the version in its canonical MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD] form.
+func (v SemVer) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 depending on whether v has lower, equal or higher precedence than
+// other. Build metadata does not take part in precedence, so 1.0.0+a and 1.0.0+b compare equal.
+func (v SemVer) Compare(other SemVer) int {
+	for _, pair := range [][2]uint64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+// LessThan reports whether v has lower precedence than other.
+func (v SemVer) LessThan(other SemVer) bool {
+	return v.Compare(other) < 0
+}
+
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1 // a release has higher precedence than any of its pre-releases
+	case b == "":
+		return -1
+	}
+
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNum, aErr := strconv.ParseUint(aParts[i], 10, 64)
+		bNum, bErr := strconv.ParseUint(bParts[i], 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNum != bNum {
+				if aNum < bNum {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			return -1 // numeric identifiers have lower precedence than alphanumeric ones
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(aParts[i], bParts[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	switch {
+	case len(aParts) < len(bParts):
+		return -1
+	case len(aParts) > len(bParts):
+		return 1
+	}
+	return 0
+}
+
+// ParseSemVer parses a semantic version string into a SemVer (string -> SemVer). A leading "v"
+// is not accepted. Errors name the segment that violates the grammar.
+func ParseSemVer() TypedMapper {
+	return namedFunc[string, SemVer]("ParseSemVer", parseSemVer)
+}
+
+// FormatSemVer formats a SemVer back into its canonical string (SemVer -> string).
+func FormatSemVer() TypedMapper {
+	return namedFunc[SemVer, string]("FormatSemVer", func(v SemVer) (string, error) {
+		return v.String(), nil
+	})
+}
+
+func parseSemVer(s string) (SemVer, error) {
+	var v SemVer
+	fail := func(format string, args ...any) (SemVer, error) {
+		return SemVer{}, fmt.Errorf("invalid semantic version %q: %s", s, fmt.Sprintf(format, args...))
+	}
+
+	rest := s
+	if i := strings.IndexByte(rest, '+'); i >= 0 {
+		v.Build = rest[i+1:]
+		rest = rest[:i]
+		if err := checkIdentifiers(v.Build, false); err != nil {
+			return fail("build metadata %q: %v", v.Build, err)
+		}
+	}
+	if i := strings.IndexByte(rest, '-'); i >= 0 {
+		v.Prerelease = rest[i+1:]
+		rest = rest[:i]
+		if err := checkIdentifiers(v.Prerelease, true); err != nil {
+			return fail("pre-release %q: %v", v.Prerelease, err)
+		}
+	}
+
+	core := strings.Split(rest, ".")
+	if len(core) != 3 {
+		return fail("expected MAJOR.MINOR.PATCH, got %q", rest)
+	}
+
+	names := []string{"major", "minor", "patch"}
+	targets := []*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range core {
+		if !isNumericIdentifier(part) {
+			return fail("%s version %q is not a number", names[i], part)
+		}
+		if len(part) > 1 && part[0] == '0' {
+			return fail("%s version %q has a leading zero", names[i], part)
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return fail("%s version %q: %v", names[i], part, err)
+		}
+		*targets[i] = n
+	}
+	return v, nil
+}
+
+// checkIdentifiers validates dot-separated pre-release or build identifiers.
+func checkIdentifiers(s string, rejectLeadingZero bool) error {
+	for _, ident := range strings.Split(s, ".") {
+		if ident == "" {
+			return fmt.Errorf("empty identifier")
+		}
+		for _, r := range ident {
+			if !(r == '-' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+				return fmt.Errorf("identifier %q contains invalid character %q", ident, r)
+			}
+		}
+		if rejectLeadingZero && len(ident) > 1 && ident[0] == '0' && isNumericIdentifier(ident) {
+			return fmt.Errorf("numeric identifier %q has a leading zero", ident)
+		}
+	}
+	return nil
+}
+
+func isNumericIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
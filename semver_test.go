@@ -0,0 +1,73 @@
+package gomorph_test
+
+import (
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSemVer(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected gomorph.SemVer
+		wantErr  string
+	}{
+		{name: "core version", input: "1.2.3", expected: gomorph.SemVer{Major: 1, Minor: 2, Patch: 3}},
+		{
+			name:     "prerelease and build",
+			input:    "1.0.0-alpha.1+exp.sha.5114f85",
+			expected: gomorph.SemVer{Major: 1, Prerelease: "alpha.1", Build: "exp.sha.5114f85"},
+		},
+		{name: "hyphen inside prerelease", input: "1.0.0-x-y-z.1", expected: gomorph.SemVer{Major: 1, Prerelease: "x-y-z.1"}},
+		{name: "missing patch", input: "1.2", wantErr: `expected MAJOR.MINOR.PATCH, got "1.2"`},
+		{name: "leading zero", input: "1.02.3", wantErr: `minor version "02" has a leading zero`},
+		{name: "not a number", input: "1.x.3", wantErr: `minor version "x" is not a number`},
+		{name: "v prefix", input: "v1.2.3", wantErr: `major version "v1" is not a number`},
+		{name: "empty prerelease identifier", input: "1.2.3-alpha..1", wantErr: `pre-release "alpha..1": empty identifier`},
+		{name: "numeric prerelease leading zero", input: "1.2.3-01", wantErr: `numeric identifier "01" has a leading zero`},
+		{name: "invalid build character", input: "1.2.3+build_1", wantErr: `identifier "build_1" contains invalid character '_'`},
+	}
+
+	mapper := gomorph.ParseSemVer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapper.From(tt.input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+
+			formatted, err := gomorph.FormatSemVer().From(got)
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, formatted)
+		})
+	}
+}
+
+func TestSemVer_Compare(t *testing.T) {
+	// Precedence example from the semver specification, in ascending order.
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "2.0.0", "2.1.0", "2.1.1",
+	}
+
+	mapper := gomorph.ParseSemVer()
+	parse := func(s string) gomorph.SemVer {
+		v, err := mapper.From(s)
+		require.NoError(t, err)
+		return v.(gomorph.SemVer)
+	}
+
+	for i := 0; i+1 < len(ordered); i++ {
+		lower, higher := parse(ordered[i]), parse(ordered[i+1])
+		assert.True(t, lower.LessThan(higher), "%s < %s", ordered[i], ordered[i+1])
+		assert.Equal(t, 1, higher.Compare(lower), "%s > %s", ordered[i+1], ordered[i])
+	}
+
+	assert.Equal(t, 0, parse("1.0.0+a").Compare(parse("1.0.0+b")))
+}
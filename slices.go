@@ -0,0 +1,181 @@
+package gomorph
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Dedup removes duplicate elements from a slice, keeping the first occurrence of each value and
+// the original order ([]T -> []T). A nil slice stays nil and an empty slice stays empty. The
+// source slice is never modified.
+func Dedup[T comparable]() TypedMapper {
+	return dedupBy("Dedup", func(v T) T { return v })
+}
+
+// DedupBy is like Dedup but identifies duplicates by the key derived from each element, which
+// allows deduplicating elements that are not comparable themselves.
+func DedupBy[T any, K comparable](key func(T) K) TypedMapper {
+	return dedupBy("DedupBy", key)
+}
+
+// dedupBy implements Dedup and DedupBy, naming the step after the calling constructor.
+func dedupBy[T any, K comparable](name string, key func(T) K) TypedMapper {
+	return namedFunc[[]T, []T](name, func(s []T) ([]T, error) {
+		if s == nil {
+			return nil, nil
+		}
+
+		seen := make(map[K]struct{}, len(s))
+		result := make([]T, 0, len(s))
+		for _, v := range s {
+			k := key(v)
+			if _, dup := seen[k]; dup {
+				continue
+			}
+			seen[k] = struct{}{}
+			result = append(result, v)
+		}
+		return result, nil
+	})
+}
+
+// Sort returns a sorted copy of a slice in ascending order ([]T -> []T). The source slice, which
+// may be shared with the input struct, is never reordered. A nil slice stays nil.
+func Sort[T cmp.Ordered]() TypedMapper {
+	return namedFunc[[]T, []T]("Sort", func(s []T) ([]T, error) {
+		sorted := slices.Clone(s)
+		slices.Sort(sorted)
+		return sorted, nil
+	})
+}
+
+// SortBy returns a copy of a slice sorted by less ([]T -> []T). The sort is stable, so elements
+// that compare equal keep their original relative order. The source slice is never reordered.
+func SortBy[T any](less func(a, b T) bool) TypedMapper {
+	return namedFunc[[]T, []T]("SortBy", func(s []T) ([]T, error) {
+		sorted := slices.Clone(s)
+		slices.SortStableFunc(sorted, func(a, b T) int {
+			switch {
+			case less(a, b):
+				return -1
+			case less(b, a):
+				return 1
+			}
+			return 0
+		})
+		return sorted, nil
+	})
+}
+
+// Reverse returns a copy of a slice in reverse order ([]T -> []T). The source slice is never
+// modified. A nil slice stays nil and an empty slice stays empty.
+func Reverse[T any]() TypedMapper {
+	return namedFunc[[]T, []T]("Reverse", func(s []T) ([]T, error) {
+		reversed := slices.Clone(s)
+		slices.Reverse(reversed)
+		return reversed, nil
+	})
+}
+
+// UnwrapSingle returns the only element of a slice ([]T -> T), for sources such as XML or
+// url.Values that wrap single values in arrays. Empty and nil slices, and slices with more than
+// one element, are errors naming the element count, so unexpected multiplicities surface instead
+// of being truncated.
+func UnwrapSingle[T any]() TypedMapper {
+	return namedFunc[[]T, T]("UnwrapSingle", func(s []T) (T, error) {
+		if len(s) != 1 {
+			var zero T
+			return zero, fmt.Errorf("expected exactly one element, got %d", len(s))
+		}
+		return s[0], nil
+	})
+}
+
+// NoNilElements validates that a slice of pointers, interfaces, maps, slices, channels or funcs
+// holds no nil element ([]T -> []T), failing with CodeNilElement and the index of the first one.
+// Interface elements holding a nil pointer count as nil. For element types that cannot be nil
+// every slice passes. The slice itself may be nil or empty.
+func NoNilElements[T any]() Validator {
+	nilable := isNilableKind(reflect.TypeFor[T]().Kind())
+	return namedFunc[[]T, []T]("NoNilElements", func(s []T) ([]T, error) {
+		if !nilable {
+			return s, nil
+		}
+		for i, elem := range s {
+			if v := reflect.ValueOf(elem); !v.IsValid() || (isNilableKind(v.Kind()) && v.IsNil()) {
+				return s, NewValidationError("", s, fmt.Sprintf("element %d is nil", i)).WithCode(CodeNilElement)
+			}
+		}
+		return s, nil
+	})
+}
+
+// CoerceSlice turns a []any, as produced by encoding/json, into a []T ([]any -> []T). Each element
+// is asserted to T, or converted when the conversion only changes the type: between types of the
+// same kind (string to a defined string type) and between numeric types when the value survives
+// the round trip, so the JSON number 3.0 becomes int 3 but 3.5 is rejected. Errors name the index
+// of the offending element. A nil slice stays nil.
+func CoerceSlice[T any]() TypedMapper {
+	target := reflect.TypeFor[T]()
+	return namedFunc[[]any, []T]("CoerceSlice", func(s []any) ([]T, error) {
+		if s == nil {
+			return nil, nil
+		}
+
+		result := make([]T, len(s))
+		for i, elem := range s {
+			if typed, ok := elem.(T); ok {
+				result[i] = typed
+				continue
+			}
+			if elem == nil && isNilableKind(target.Kind()) {
+				// A nil element, such as a JSON null, stays the zero T.
+				continue
+			}
+			v, ok := coerceValue(reflect.ValueOf(elem), target)
+			if !ok {
+				v, ok = coerceNumber(reflect.ValueOf(elem), target)
+			}
+			if !ok {
+				return nil, fmt.Errorf("element %d: cannot coerce %T (%v) to %v", i, elem, elem, target)
+			}
+			result[i] = v.Interface().(T)
+		}
+		return result, nil
+	})
+}
+
+// coerceNumber converts between numeric kinds when no information is lost, i.e. when converting
+// the result back yields the original value.
+func coerceNumber(v reflect.Value, target reflect.Type) (reflect.Value, bool) {
+	if !v.IsValid() || !isNumericKind(v.Kind()) || !isNumericKind(target.Kind()) {
+		return v, false
+	}
+	converted := v.Convert(target)
+	if !converted.Convert(v.Type()).Equal(v) || isNegative(converted) != isNegative(v) {
+		return v, false
+	}
+	return converted, true
+}
+
+func isNegative(v reflect.Value) bool {
+	switch {
+	case v.CanInt():
+		return v.Int() < 0
+	case v.CanFloat():
+		return v.Float() < 0
+	}
+	return false
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
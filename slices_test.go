@@ -0,0 +1,240 @@
+package gomorph_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedup(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{name: "keeps first occurrence order", input: []string{"b", "a", "b", "c", "a"}, expected: []string{"b", "a", "c"}},
+		{name: "no duplicates", input: []string{"a", "b"}, expected: []string{"a", "b"}},
+		{name: "empty stays empty", input: []string{}, expected: []string{}},
+		{name: "nil stays nil", input: nil, expected: nil},
+	}
+
+	mapper := gomorph.Dedup[string]()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapper.From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	assert.Equal(t, reflect.TypeOf([]string{}), mapper.SourceType())
+	assert.Equal(t, reflect.TypeOf([]string{}), mapper.TargetType())
+}
+
+func TestDedupBy(t *testing.T) {
+	type tagged struct {
+		ID   int
+		Tags []string // makes the struct non-comparable
+	}
+
+	input := []tagged{{ID: 1, Tags: []string{"a"}}, {ID: 2}, {ID: 1, Tags: []string{"b"}}}
+	got, err := gomorph.DedupBy(func(v tagged) int { return v.ID }).From(input)
+	require.NoError(t, err)
+	assert.Equal(t, []tagged{{ID: 1, Tags: []string{"a"}}, {ID: 2}}, got)
+	assert.Len(t, input, 3, "source is not modified")
+}
+
+func TestSort(t *testing.T) {
+	input := []int{3, 1, 2}
+	got, err := gomorph.Sort[int]().From(input)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, got)
+	assert.Equal(t, []int{3, 1, 2}, input, "source is not reordered")
+
+	got, err = gomorph.Sort[string]().From([]string(nil))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestSortBy(t *testing.T) {
+	type player struct {
+		Name  string
+		Score int
+	}
+	input := []player{{"a", 2}, {"b", 1}, {"c", 2}, {"d", 1}}
+
+	got, err := gomorph.SortBy(func(a, b player) bool { return a.Score < b.Score }).From(input)
+	require.NoError(t, err)
+	assert.Equal(t, []player{{"b", 1}, {"d", 1}, {"a", 2}, {"c", 2}}, got, "equal elements keep their order")
+	assert.Equal(t, "a", input[0].Name, "source is not reordered")
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{name: "several", input: []int{1, 2, 3}, expected: []int{3, 2, 1}},
+		{name: "single", input: []int{7}, expected: []int{7}},
+		{name: "empty", input: []int{}, expected: []int{}},
+		{name: "nil", input: nil, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.input)
+			got, err := gomorph.Reverse[int]().From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+			assert.Equal(t, original, tt.input, "source is not modified")
+		})
+	}
+
+	descending := gomorph.NewChainedMapper[[]int, []int](gomorph.Sort[int](), gomorph.Reverse[int]())
+	got, err := descending.Map([]int{2, 3, 1})
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 2, 1}, got)
+}
+
+func TestCoerceSlice(t *testing.T) {
+	t.Run("json numbers to ints", func(t *testing.T) {
+		got, err := gomorph.CoerceSlice[int]().From([]any{1.0, 2.0, float64(3)})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, got)
+	})
+
+	t.Run("defined string type", func(t *testing.T) {
+		got, err := gomorph.CoerceSlice[CharacterClass]().From([]any{"Wizard", CharacterClass("Rogue")})
+		require.NoError(t, err)
+		assert.Equal(t, []CharacterClass{"Wizard", "Rogue"}, got)
+	})
+
+	t.Run("interface elements", func(t *testing.T) {
+		got, err := gomorph.CoerceSlice[Shape]().From([]any{Square{Side: 1}})
+		require.NoError(t, err)
+		assert.Equal(t, []Shape{Square{Side: 1}}, got)
+	})
+
+	t.Run("nil elements", func(t *testing.T) {
+		nilTests := []struct {
+			name    string
+			mapper  gomorph.TypedMapper
+			input   []any
+			want    any
+			wantErr string
+		}{
+			{name: "any", mapper: gomorph.CoerceSlice[any](), input: []any{nil, "x"}, want: []any{nil, "x"}},
+			{name: "error", mapper: gomorph.CoerceSlice[error](), input: []any{nil}, want: []error{nil}},
+			{name: "pointer", mapper: gomorph.CoerceSlice[*int](), input: []any{nil}, want: []*int{nil}},
+			{name: "concrete", mapper: gomorph.CoerceSlice[string](), input: []any{nil}, wantErr: "element 0: cannot coerce <nil> (<nil>) to string"},
+		}
+		for _, tt := range nilTests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := tt.mapper.From(tt.input)
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			})
+		}
+	})
+
+	t.Run("nil and empty", func(t *testing.T) {
+		got, err := gomorph.CoerceSlice[string]().From([]any(nil))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		got, err = gomorph.CoerceSlice[string]().From([]any{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, got)
+	})
+
+	tests := []struct {
+		name    string
+		mapper  gomorph.TypedMapper
+		input   []any
+		wantErr string
+	}{
+		{name: "mixed strings", mapper: gomorph.CoerceSlice[string](), input: []any{"hello", 123, "test"}, wantErr: "element 1: cannot coerce int (123) to string"},
+		{name: "fraction to int", mapper: gomorph.CoerceSlice[int](), input: []any{1.0, 2.5}, wantErr: "element 1: cannot coerce float64 (2.5) to int"},
+		{name: "negative to uint", mapper: gomorph.CoerceSlice[uint](), input: []any{-1}, wantErr: "element 0: cannot coerce int (-1) to uint"},
+		{name: "overflow", mapper: gomorph.CoerceSlice[int8](), input: []any{300.0}, wantErr: "element 0: cannot coerce float64 (300) to int8"},
+		{name: "nil element", mapper: gomorph.CoerceSlice[int](), input: []any{nil}, wantErr: "element 0: cannot coerce <nil> (<nil>) to int"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.mapper.From(tt.input)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUnwrapSingle(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected string
+		wantErr  string
+	}{
+		{name: "single element", input: []string{"a"}, expected: "a"},
+		{name: "empty", input: []string{}, wantErr: "expected exactly one element, got 0"},
+		{name: "nil", input: nil, wantErr: "expected exactly one element, got 0"},
+		{name: "several", input: []string{"a", "b", "c"}, wantErr: "expected exactly one element, got 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomorph.UnwrapSingle[string]().From(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNoNilElements(t *testing.T) {
+	one, two := 1, 2
+	var nilSquare *Square
+
+	t.Run("pointers", func(t *testing.T) {
+		got, err := gomorph.NoNilElements[*int]().From([]*int{&one, &two})
+		require.NoError(t, err)
+		assert.Equal(t, []*int{&one, &two}, got)
+
+		_, err = gomorph.NoNilElements[*int]().From([]*int{&one, nil, nil})
+		assert.EqualError(t, err, "validation failed: element 1 is nil")
+		var validationErr *gomorph.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, gomorph.CodeNilElement, validationErr.Code)
+	})
+
+	t.Run("interfaces", func(t *testing.T) {
+		_, err := gomorph.NoNilElements[Shape]().From([]Shape{Square{}, nil})
+		assert.EqualError(t, err, "validation failed: element 1 is nil")
+
+		_, err = gomorph.NoNilElements[Shape]().From([]Shape{nilSquare})
+		assert.EqualError(t, err, "validation failed: element 0 is nil", "a nil pointer in an interface counts as nil")
+	})
+
+	t.Run("non-nilable elements always pass", func(t *testing.T) {
+		_, err := gomorph.NoNilElements[int]().From([]int{0, 0})
+		assert.NoError(t, err)
+	})
+
+	t.Run("nil and empty slices pass", func(t *testing.T) {
+		_, err := gomorph.NoNilElements[*int]().From([]*int(nil))
+		assert.NoError(t, err)
+		_, err = gomorph.NoNilElements[map[string]int]().From([]map[string]int{})
+		assert.NoError(t, err)
+	})
+}
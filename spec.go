@@ -0,0 +1,159 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MappingSpec declares a single field mapping as data so mappings can be loaded from JSON or YAML
+// and resolved against a ConverterRegistry. Converters are applied in order; an empty list copies
+// the value unchanged.
+type MappingSpec struct {
+	From       string   `json:"from" yaml:"from"`
+	To         string   `json:"to" yaml:"to"`
+	Converters []string `json:"converters,omitempty" yaml:"converters,omitempty"`
+}
+
+// BuildStructMapper assembles a StructMapper from spec, looking up every converter by name in reg.
+// Unknown converter names, missing fields and type mismatches between fields and converters are
+// reported here rather than when the mapper is first used. Source fields of interface type, such
+// as the values of a map[string]any, can only be checked at mapping time.
+func BuildStructMapper[TSource, TDest any](spec []MappingSpec, reg *ConverterRegistry, opts ...StructOption) (StructMapper[TSource, TDest], error) {
+	sourceType := reflect.TypeFor[TSource]()
+	destType := reflect.TypeFor[TDest]()
+
+	mappings := make([]FieldMapper, 0, len(spec))
+	for i, s := range spec {
+		mapping, err := buildSpecMapping(sourceType, destType, s, reg)
+		if err != nil {
+			return StructMapper[TSource, TDest]{}, fmt.Errorf("mapping spec %d (%s -> %s): %w", i, s.From, s.To, err)
+		}
+		mappings = append(mappings, mapping)
+	}
+	return NewStructMapper[TSource, TDest](mappings, opts...), nil
+}
+
+func buildSpecMapping(sourceType, destType reflect.Type, s MappingSpec, reg *ConverterRegistry) (FieldMapper, error) {
+	fromType, ok := sourceFieldType(sourceType, s.From)
+	if !ok {
+		return nil, fmt.Errorf("source field %q not found on %v", s.From, sourceType)
+	}
+	toType, ok := destFieldType(destType, s.To)
+	if !ok {
+		return nil, fmt.Errorf("destination field %q not found on %v", s.To, destType)
+	}
+
+	mappers := make([]TypedMapper, 0, len(s.Converters))
+	for _, name := range s.Converters {
+		if reg == nil {
+			return nil, fmt.Errorf("unknown converter %q: no registry provided", name)
+		}
+		converter, ok := reg.Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown converter %q", name)
+		}
+		mappers = append(mappers, converter)
+	}
+
+	produced := fromType
+	if len(mappers) > 0 {
+		if first := mappers[0].SourceType(); !specAccepts(first, fromType) {
+			return nil, fmt.Errorf("converter %q accepts %v, but source field %q is %v", s.Converters[0], first, s.From, fromType)
+		}
+		if err := validateAdjacent(mappers); err != nil {
+			return nil, err
+		}
+		produced = mappers[len(mappers)-1].TargetType()
+	}
+	if !specAssignable(produced, toType) {
+		return nil, fmt.Errorf("cannot assign %v to destination field %q of type %v", produced, s.To, toType)
+	}
+
+	return specFieldMapping{
+		from:    fieldInfo{name: s.From, typ: fromType},
+		to:      fieldInfo{name: s.To, typ: toType},
+		mappers: mappers,
+	}, nil
+}
+
+// specFieldMapping is the FieldMapper built from a MappingSpec. Its types are only known at
+// runtime, so it runs the converters directly instead of through a typed ChainedMapper.
+type specFieldMapping struct {
+	from    fieldInfo
+	to      fieldInfo
+	mappers []TypedMapper
+}
+
+func (m specFieldMapping) From() Field {
+	return m.from
+}
+
+func (m specFieldMapping) To() Field {
+	return m.to
+}
+
+func (m specFieldMapping) Map(value any) (FieldMappingResult, error) {
+	mapped, err := runChain(m.mappers, value, false)
+	if err != nil {
+		return NewFieldMappingResult(m.to, NewTypedValue(nil)), err
+	}
+	return NewFieldMappingResult(m.to, NewTypedValue(mapped)), nil
+}
+
+// specAccepts reports whether a converter accepting accepted can be fed a value of type given.
+// A nil accepted type is an interface-typed converter; an interface given type is checked later.
+func specAccepts(accepted, given reflect.Type) bool {
+	return accepted == nil || given.Kind() == reflect.Interface || given == accepted
+}
+
+// specAssignable mirrors the rules used by assignValue, deferring interface types to runtime.
+func specAssignable(produced, target reflect.Type) bool {
+	if produced == nil || produced.Kind() == reflect.Interface {
+		return true
+	}
+	return produced.AssignableTo(target) ||
+		(produced.Kind() == target.Kind() && produced.ConvertibleTo(target))
+}
+
+// sourceFieldType returns the type read for name by the default accessors: an exported struct
+// field, the element type of a map with string keys, or the result of a zero-argument getter.
+func sourceFieldType(t reflect.Type, name string) (reflect.Type, bool) {
+	base := t
+	if base.Kind() == reflect.Ptr {
+		base = base.Elem()
+	}
+	switch base.Kind() {
+	case reflect.Struct:
+		if f, ok := base.FieldByName(name); ok && f.IsExported() {
+			return f.Type, true
+		}
+	case reflect.Map:
+		if base.Key().Kind() == reflect.String {
+			return base.Elem(), true
+		}
+	}
+	for _, candidate := range []reflect.Type{t, reflect.PointerTo(base)} {
+		if m, ok := candidate.MethodByName(name); ok && m.Type.NumIn() == 1 && m.Type.NumOut() == 1 {
+			return m.Type.Out(0), true
+		}
+	}
+	return nil, false
+}
+
+// destFieldType returns the type assigned to name: a settable struct field or the argument of a
+// single-argument setter method.
+func destFieldType(t reflect.Type, name string) (reflect.Type, bool) {
+	base := t
+	if base.Kind() == reflect.Ptr {
+		base = base.Elem()
+	}
+	if base.Kind() == reflect.Struct {
+		if f, ok := base.FieldByName(name); ok && f.IsExported() {
+			return f.Type, true
+		}
+	}
+	if m, ok := reflect.PointerTo(base).MethodByName(name); ok && m.Type.NumIn() == 2 {
+		return m.Type.In(1), true
+	}
+	return nil, false
+}
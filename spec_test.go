@@ -0,0 +1,94 @@
+package gomorph_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func specRegistry() *gomorph.ConverterRegistry {
+	registry := gomorph.NewConverterRegistry()
+	registry.MustRegister("length", StringToIntMapper{})
+	registry.MustRegister("double", IntDoubler{})
+	return registry
+}
+
+func TestBuildStructMapper_FromJSON(t *testing.T) {
+	config := `[
+		{"from": "InputString", "to": "MappedInputString"},
+		{"from": "InputString", "to": "MappedInputInt", "converters": ["length", "double"]}
+	]`
+	var spec []gomorph.MappingSpec
+	require.NoError(t, json.Unmarshal([]byte(config), &spec))
+
+	mapper, err := gomorph.BuildStructMapper[Input, Output](spec, specRegistry())
+	require.NoError(t, err)
+
+	out, err := mapper.From(Input{InputString: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, Output{MappedInputString: "hello", MappedInputInt: 10}, out)
+}
+
+func TestBuildStructMapper_FromMapSource(t *testing.T) {
+	spec := []gomorph.MappingSpec{
+		{From: "name", To: "MappedInputInt", Converters: []string{"length"}},
+	}
+	mapper, err := gomorph.BuildStructMapper[map[string]any, Output](spec, specRegistry())
+	require.NoError(t, err)
+
+	out, err := mapper.From(map[string]any{"name": "abc"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, out.MappedInputInt)
+
+	_, err = mapper.From(map[string]any{"name": 42})
+	assert.ErrorContains(t, err, "mapping error [name]: mapper chain failed at step 1")
+}
+
+func TestBuildStructMapper_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    gomorph.MappingSpec
+		wantErr string
+	}{
+		{
+			name:    "unknown converter",
+			spec:    gomorph.MappingSpec{From: "InputString", To: "MappedInputInt", Converters: []string{"missing"}},
+			wantErr: `mapping spec 0 (InputString -> MappedInputInt): unknown converter "missing"`,
+		},
+		{
+			name:    "missing source field",
+			spec:    gomorph.MappingSpec{From: "Nope", To: "MappedInputString"},
+			wantErr: `mapping spec 0 (Nope -> MappedInputString): source field "Nope" not found on gomorph_test.Input`,
+		},
+		{
+			name:    "missing destination field",
+			spec:    gomorph.MappingSpec{From: "InputString", To: "Nope"},
+			wantErr: `mapping spec 0 (InputString -> Nope): destination field "Nope" not found on gomorph_test.Output`,
+		},
+		{
+			name:    "converter input mismatch",
+			spec:    gomorph.MappingSpec{From: "InputInt", To: "MappedInputInt", Converters: []string{"length"}},
+			wantErr: `mapping spec 0 (InputInt -> MappedInputInt): converter "length" accepts string, but source field "InputInt" is int`,
+		},
+		{
+			name:    "converters do not line up",
+			spec:    gomorph.MappingSpec{From: "InputInt", To: "MappedInputInt", Converters: []string{"double", "length"}},
+			wantErr: `mapping spec 0 (InputInt -> MappedInputInt): type mismatch between mapper 0 output and mapper 1 input: int is not string`,
+		},
+		{
+			name:    "destination mismatch",
+			spec:    gomorph.MappingSpec{From: "InputString", To: "MappedInputString", Converters: []string{"length"}},
+			wantErr: `mapping spec 0 (InputString -> MappedInputString): cannot assign int to destination field "MappedInputString" of type string`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gomorph.BuildStructMapper[Input, Output]([]gomorph.MappingSpec{tt.spec}, specRegistry())
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
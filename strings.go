@@ -0,0 +1,352 @@
+package gomorph
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RuneToString converts a single rune into its UTF-8 encoded string. Invalid runes (surrogate
+// halves or values beyond utf8.MaxRune) are rejected instead of silently becoming U+FFFD.
+func RuneToString() TypedMapper {
+	return namedFunc[rune, string]("RuneToString", func(r rune) (string, error) {
+		if !utf8.ValidRune(r) {
+			return "", fmt.Errorf("invalid rune %U", r)
+		}
+		return string(r), nil
+	})
+}
+
+// CodePointToString converts an int holding a Unicode code point into its string form. Unlike
+// string(rune(i)) it rejects integers that are not valid code points, so a plain number can not
+// be silently reinterpreted as a character.
+func CodePointToString() TypedMapper {
+	return namedFunc[int, string]("CodePointToString", func(i int) (string, error) {
+		if i < 0 || i > utf8.MaxRune || !utf8.ValidRune(rune(i)) {
+			return "", fmt.Errorf("invalid code point %d", i)
+		}
+		return string(rune(i)), nil
+	})
+}
+
+// StringToRunes decodes a string into its runes. Invalid UTF-8 sequences are rejected with the
+// byte offset at which they occur.
+func StringToRunes() TypedMapper {
+	return namedFunc[string, []rune]("StringToRunes", func(s string) ([]rune, error) {
+		for i, r := range s {
+			if r == utf8.RuneError {
+				if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+					return nil, fmt.Errorf("invalid UTF-8 at byte %d", i)
+				}
+			}
+		}
+		return []rune(s), nil
+	})
+}
+
+// BytesToString converts raw bytes into a string without any decoding or validation; the bytes
+// are copied as-is.
+func BytesToString() TypedMapper {
+	return namedFunc[[]byte, string]("BytesToString", func(b []byte) (string, error) {
+		return string(b), nil
+	})
+}
+
+// StringToBytes converts a string into its UTF-8 encoded bytes.
+func StringToBytes() TypedMapper {
+	return namedFunc[string, []byte]("StringToBytes", func(s string) ([]byte, error) {
+		return []byte(s), nil
+	})
+}
+
+// ParseEmail trims and validates an email address with net/mail and returns it with the domain
+// lowercased. The local part is left untouched because it is case sensitive by specification.
+// Only bare addresses are accepted; input with a display name such as "Bob <bob@x.com>" is
+// rejected. Failures are *ValidationErrors with CodeInvalidEmail.
+func ParseEmail() TypedMapper {
+	return namedFunc[string, string]("ParseEmail", func(s string) (string, error) {
+		trimmed := strings.TrimSpace(s)
+		addr, err := mail.ParseAddress(trimmed)
+		if err != nil {
+			return "", NewValidationError("", s, fmt.Sprintf("invalid email address %q: %v", s, err)).
+				WithCode(CodeInvalidEmail)
+		}
+		if addr.Name != "" || strings.ContainsAny(trimmed, "<>") {
+			return "", NewValidationError("", s, fmt.Sprintf("invalid email address %q: display names are not allowed", s)).
+				WithCode(CodeInvalidEmail)
+		}
+
+		at := strings.LastIndex(addr.Address, "@")
+		return addr.Address[:at] + "@" + strings.ToLower(addr.Address[at+1:]), nil
+	})
+}
+
+// RequirePrefix validates and strips a mandatory prefix (string -> string), so with prefix "sku:"
+// the value "sku:ABC123" becomes "ABC123". Values without the prefix fail with a ValidationError
+// carrying CodeMissingPrefix.
+func RequirePrefix(prefix string) TypedMapper {
+	return namedFunc[string, string]("RequirePrefix", func(s string) (string, error) {
+		rest, ok := strings.CutPrefix(s, prefix)
+		if !ok {
+			return "", NewValidationError("", s, fmt.Sprintf("%q does not start with %q", s, prefix)).
+				WithCode(CodeMissingPrefix)
+		}
+		return rest, nil
+	})
+}
+
+// RequireSuffix is the counterpart of RequirePrefix for a mandatory suffix, failing with
+// CodeMissingSuffix.
+func RequireSuffix(suffix string) TypedMapper {
+	return namedFunc[string, string]("RequireSuffix", func(s string) (string, error) {
+		rest, ok := strings.CutSuffix(s, suffix)
+		if !ok {
+			return "", NewValidationError("", s, fmt.Sprintf("%q does not end with %q", s, suffix)).
+				WithCode(CodeMissingSuffix)
+		}
+		return rest, nil
+	})
+}
+
+// ValidateUTF8 checks that a string is valid UTF-8 (string -> string), as required by JSON
+// encoders and most databases. Invalid input fails with CodeInvalidUTF8 and a message giving the
+// byte offset of the first invalid sequence.
+func ValidateUTF8() Validator {
+	return namedFunc[string, string]("ValidateUTF8", func(s string) (string, error) {
+		for i, r := range s {
+			if r == utf8.RuneError {
+				if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+					return s, NewValidationError("", s, fmt.Sprintf("invalid UTF-8 at byte %d", i)).
+						WithCode(CodeInvalidUTF8)
+				}
+			}
+		}
+		return s, nil
+	})
+}
+
+// RepairUTF8 replaces invalid UTF-8 in a string with replacement (string -> string). Each run of
+// consecutive invalid bytes becomes a single replacement, as with strings.ToValidUTF8; a
+// replacement of -1 drops invalid bytes instead.
+func RepairUTF8(replacement rune) TypedMapper {
+	repl := ""
+	if replacement >= 0 {
+		repl = string(replacement)
+	}
+	return namedFunc[string, string]("RepairUTF8", func(s string) (string, error) {
+		return strings.ToValidUTF8(s, repl), nil
+	})
+}
+
+// TruncateString cuts strings down to at most maxBytes bytes (string -> string), for columns
+// with a byte limit. With onUTF8Boundary set the cut backs off to the start of the character it
+// would split, so the result stays valid UTF-8 and may be a few bytes shorter than maxBytes.
+// Shorter strings are returned unchanged.
+func TruncateString(maxBytes int, onUTF8Boundary bool) TypedMapper {
+	checkByteLimit("TruncateString", maxBytes)
+	return namedFunc[string, string]("TruncateString", func(s string) (string, error) {
+		if len(s) <= maxBytes {
+			return s, nil
+		}
+		cut := maxBytes
+		if onUTF8Boundary {
+			for cut > 0 && !utf8.RuneStart(s[cut]) {
+				cut--
+			}
+		}
+		return s[:cut], nil
+	})
+}
+
+// MaxBytes validates that a string is at most n bytes long (string -> string), failing with
+// CodeTooLong instead of truncating. It is the validating counterpart of TruncateString, and
+// likewise panics on a negative n.
+func MaxBytes(n int) Validator {
+	checkByteLimit("MaxBytes", n)
+	return namedFunc[string, string]("MaxBytes", func(s string) (string, error) {
+		if len(s) > n {
+			return s, NewValidationError("", s, fmt.Sprintf("value is %d bytes long, at most %d allowed", len(s), n)).
+				WithCode(CodeTooLong)
+		}
+		return s, nil
+	})
+}
+
+// checkByteLimit panics when the byte limit n given to the constructor caller is negative.
+func checkByteLimit(caller string, n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("%s: negative byte limit %d", caller, n))
+	}
+}
+
+// RegexReplacement is a single substitution applied by ReplaceAllRegex. Repl may refer to
+// capture groups as in regexp.Regexp.ReplaceAllString, e.g. "$1".
+type RegexReplacement struct {
+	Pattern string
+	Repl    string
+}
+
+// ReplaceAllRegex applies a sequence of regexp substitutions to a string (string -> string). Each
+// pattern is compiled once, up front, and the replacements run in the order given, each one on
+// the output of the previous, so later patterns see earlier substitutions:
+//
+//	[{`[^\w\s-]`, ""}, {`[\s-]+`, "-"}]   "Hello,  World - 2" -> "Hello-World-2"
+//
+// An invalid pattern is reported with its index.
+func ReplaceAllRegex(replacements []RegexReplacement) (TypedMapper, error) {
+	compiled := make([]*regexp.Regexp, len(replacements))
+	repls := make([]string, len(replacements))
+	for i, r := range replacements {
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("replacement %d: %w", i, err)
+		}
+		compiled[i], repls[i] = re, r.Repl
+	}
+
+	return namedFunc[string, string]("ReplaceAllRegex", func(s string) (string, error) {
+		for i, re := range compiled {
+			s = re.ReplaceAllString(s, repls[i])
+		}
+		return s, nil
+	}), nil
+}
+
+// MustReplaceAllRegex is like ReplaceAllRegex but panics on an invalid pattern. It is intended
+// for package-level variables.
+func MustReplaceAllRegex(replacements []RegexReplacement) TypedMapper {
+	mapper, err := ReplaceAllRegex(replacements)
+	if err != nil {
+		panic(err.Error())
+	}
+	return mapper
+}
+
+// NormalizeDigits removes every character that is not an ASCII digit (string -> string), turning
+// "+1 (555) 010-9999" into "15550109999". It never fails; pair it with a validator such as
+// DigitsOnly or LuhnValidate to reject input left empty or malformed.
+func NormalizeDigits() TypedMapper {
+	return namedFunc[string, string]("NormalizeDigits", func(s string) (string, error) {
+		return strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, s), nil
+	})
+}
+
+// NormalizeNewlines replaces every line ending, whether CRLF, lone CR or LF, with to
+// (string -> string). A CRLF pair counts as a single line ending, so it is never doubled. An empty
+// to means "\n".
+func NormalizeNewlines(to string) TypedMapper {
+	if to == "" {
+		to = "\n"
+	}
+	replacer := strings.NewReplacer("\r\n", to, "\r", to, "\n", to)
+	return namedFunc[string, string]("NormalizeNewlines", func(s string) (string, error) {
+		return replacer.Replace(s), nil
+	})
+}
+
+// SplitCamelCase splits a camelCase or PascalCase identifier into its words
+// (string -> []string). A new word starts at an upper-case letter following a lower-case letter
+// or digit, and at the last upper-case letter of an acronym that is followed by a lower-case
+// letter, so acronyms stay whole. Digits belong to the word before them. Underscores, hyphens
+// and spaces also separate words and are dropped.
+//
+//	"userName"     -> ["user", "Name"]
+//	"HTTPServer"   -> ["HTTP", "Server"]
+//	"base64Encode" -> ["base64", "Encode"]
+func SplitCamelCase() TypedMapper {
+	return namedFunc[string, []string]("SplitCamelCase", func(s string) ([]string, error) {
+		return splitCamelCase(s), nil
+	})
+}
+
+// Humanize turns an identifier into a display label by splitting it with SplitCamelCase and
+// joining the words with spaces, upper-casing the first letter of each word (string -> string).
+// Acronyms are kept as written: "userID" becomes "User ID".
+func Humanize() TypedMapper {
+	return namedFunc[string, string]("Humanize", func(s string) (string, error) {
+		words := splitCamelCase(s)
+		for i, word := range words {
+			r, size := utf8.DecodeRuneInString(word)
+			words[i] = string(unicode.ToUpper(r)) + word[size:]
+		}
+		return strings.Join(words, " "), nil
+	})
+}
+
+// CamelToSnake converts a camelCase or PascalCase value to snake_case (string -> string). Words
+// are found with SplitCamelCase, lower-cased and joined with underscores, so acronyms and digits
+// stay with their word: "HTTPServer" becomes "http_server" and "base64Encode" "base64_encode".
+func CamelToSnake() TypedMapper {
+	return namedFunc[string, string]("CamelToSnake", func(s string) (string, error) {
+		words := splitCamelCase(s)
+		for i, word := range words {
+			words[i] = strings.ToLower(word)
+		}
+		return strings.Join(words, "_"), nil
+	})
+}
+
+// SnakeToCamel converts a snake_case value to camelCase (string -> string). The value is split on
+// underscores, ignoring leading, trailing and repeated ones, and every word is lower-cased; words
+// after the first then get an upper-case first letter. A word starting with a digit is left as
+// is, so "address_2_line" becomes "address2Line" and "2fa_code" "2faCode".
+func SnakeToCamel() TypedMapper {
+	return namedFunc[string, string]("SnakeToCamel", func(s string) (string, error) {
+		var b strings.Builder
+		for _, word := range strings.Split(s, "_") {
+			if word == "" {
+				continue
+			}
+			word = strings.ToLower(word)
+			if b.Len() > 0 {
+				r, size := utf8.DecodeRuneInString(word)
+				word = string(unicode.ToUpper(r)) + word[size:]
+			}
+			b.WriteString(word)
+		}
+		return b.String(), nil
+	})
+}
+
+func splitCamelCase(s string) []string {
+	words := []string{}
+	runes := []rune(s)
+	start := 0
+
+	flush := func(end int) {
+		if end > start {
+			words = append(words, string(runes[start:end]))
+		}
+	}
+
+	for i, r := range runes {
+		if r == '_' || r == '-' || unicode.IsSpace(r) {
+			flush(i)
+			start = i + 1
+			continue
+		}
+		if i == start {
+			continue
+		}
+
+		prev := runes[i-1]
+		switch {
+		case unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+			flush(i)
+			start = i
+		case unicode.IsUpper(r) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+			flush(i)
+			start = i
+		}
+	}
+	flush(len(runes))
+	return words
+}
@@ -0,0 +1,370 @@
+package gomorph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextConverters(t *testing.T) {
+	tests := []struct {
+		name     string
+		mapper   gomorph.TypedMapper
+		input    any
+		expected any
+		wantErr  string
+	}{
+		{name: "rune to string", mapper: gomorph.RuneToString(), input: 'é', expected: "é"},
+		{name: "invalid rune", mapper: gomorph.RuneToString(), input: rune(0xD800), wantErr: "invalid rune U+D800"},
+		{name: "code point to string", mapper: gomorph.CodePointToString(), input: 65, expected: "A"},
+		{name: "negative code point", mapper: gomorph.CodePointToString(), input: -1, wantErr: "invalid code point -1"},
+		{name: "code point out of range", mapper: gomorph.CodePointToString(), input: 0x110000, wantErr: "invalid code point 1114112"},
+		{name: "string to runes", mapper: gomorph.StringToRunes(), input: "héllo", expected: []rune{'h', 'é', 'l', 'l', 'o'}},
+		{name: "string to runes invalid utf8", mapper: gomorph.StringToRunes(), input: "ab\xffc", wantErr: "invalid UTF-8 at byte 2"},
+		{name: "string to runes keeps encoded replacement char", mapper: gomorph.StringToRunes(), input: "�", expected: []rune{'�'}},
+		{name: "bytes to string", mapper: gomorph.BytesToString(), input: []byte("hi"), expected: "hi"},
+		{name: "string to bytes", mapper: gomorph.StringToBytes(), input: "hi", expected: []byte("hi")},
+		{name: "wrong input type", mapper: gomorph.StringToBytes(), input: 1, wantErr: "expected string, got int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.mapper.From(tt.input)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTextConverters_TypeInfo(t *testing.T) {
+	mapper := gomorph.CodePointToString()
+	assert.Equal(t, reflect.TypeOf(0), mapper.SourceType())
+	assert.Equal(t, reflect.TypeOf(""), mapper.TargetType())
+
+	chained := gomorph.NewChainedMapper[string, []rune](gomorph.StringToRunes())
+	runes, err := chained.Map("ok")
+	require.NoError(t, err)
+	assert.Equal(t, []rune("ok"), runes)
+}
+
+func TestParseEmail(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "already normalized", input: "bob@example.com", expected: "bob@example.com"},
+		{name: "trims and lowercases domain", input: "  Bob.Smith@Example.COM ", expected: "Bob.Smith@example.com"},
+		{name: "missing at sign", input: "bob.example.com", wantErr: true},
+		{name: "missing domain", input: "bob@", wantErr: true},
+		{name: "display name", input: "Bob <bob@example.com>", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	mapper := gomorph.ParseEmail()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapper.From(tt.input)
+			if tt.wantErr {
+				var validationErr *gomorph.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, gomorph.CodeInvalidEmail, validationErr.Code)
+				assert.Equal(t, tt.input, validationErr.Value)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestSplitCamelCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "userName", expected: []string{"user", "Name"}},
+		{input: "UserName", expected: []string{"User", "Name"}},
+		{input: "HTTPServer", expected: []string{"HTTP", "Server"}},
+		{input: "userID", expected: []string{"user", "ID"}},
+		{input: "base64Encode", expected: []string{"base64", "Encode"}},
+		{input: "parseJSONData", expected: []string{"parse", "JSON", "Data"}},
+		{input: "snake_case-mixed Words", expected: []string{"snake", "case", "mixed", "Words"}},
+		{input: "x", expected: []string{"x"}},
+		{input: "", expected: []string{}},
+	}
+
+	mapper := gomorph.SplitCamelCase()
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := mapper.From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestHumanize(t *testing.T) {
+	tests := map[string]string{
+		"userID":        "User ID",
+		"firstName":     "First Name",
+		"HTTPServer":    "HTTP Server",
+		"created_at":    "Created At",
+		"éclairFlavour": "Éclair Flavour",
+	}
+
+	mapper := gomorph.Humanize()
+	for input, expected := range tests {
+		got, err := mapper.From(input)
+		require.NoError(t, err)
+		assert.Equal(t, expected, got, input)
+	}
+}
+
+func TestCamelToSnake(t *testing.T) {
+	tests := map[string]string{
+		"userName":      "user_name",
+		"UserName":      "user_name",
+		"HTTPServer":    "http_server",
+		"base64Encode":  "base64_encode",
+		"already_snake": "already_snake",
+		"":              "",
+	}
+
+	mapper := gomorph.CamelToSnake()
+	for input, expected := range tests {
+		got, err := mapper.From(input)
+		require.NoError(t, err)
+		assert.Equal(t, expected, got, input)
+	}
+}
+
+func TestSnakeToCamel(t *testing.T) {
+	tests := map[string]string{
+		"user_name":      "userName",
+		"USER_NAME":      "userName",
+		"address_2_line": "address2Line",
+		"2fa_code":       "2faCode",
+		"_private__key_": "privateKey",
+		"single":         "single",
+		"":               "",
+	}
+
+	mapper := gomorph.SnakeToCamel()
+	for input, expected := range tests {
+		got, err := mapper.From(input)
+		require.NoError(t, err)
+		assert.Equal(t, expected, got, input)
+	}
+}
+
+func TestRequirePrefixAndSuffix(t *testing.T) {
+	tests := []struct {
+		name     string
+		mapper   gomorph.TypedMapper
+		input    string
+		expected string
+		wantCode string
+	}{
+		{name: "prefix stripped", mapper: gomorph.RequirePrefix("sku:"), input: "sku:ABC123", expected: "ABC123"},
+		{name: "prefix only", mapper: gomorph.RequirePrefix("sku:"), input: "sku:", expected: ""},
+		{name: "prefix missing", mapper: gomorph.RequirePrefix("sku:"), input: "ABC123", wantCode: gomorph.CodeMissingPrefix},
+		{name: "prefix is case sensitive", mapper: gomorph.RequirePrefix("sku:"), input: "SKU:ABC123", wantCode: gomorph.CodeMissingPrefix},
+		{name: "suffix stripped", mapper: gomorph.RequireSuffix(".json"), input: "config.json", expected: "config"},
+		{name: "suffix missing", mapper: gomorph.RequireSuffix(".json"), input: "config.yaml", wantCode: gomorph.CodeMissingSuffix},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.mapper.From(tt.input)
+			if tt.wantCode != "" {
+				var validationErr *gomorph.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.wantCode, validationErr.Code)
+				assert.Equal(t, tt.input, validationErr.Value)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	_, err := gomorph.RequirePrefix("sku:").From("ABC")
+	assert.EqualError(t, err, `validation failed: "ABC" does not start with "sku:"`)
+}
+
+func TestValidateUTF8(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "ascii", input: "hello"},
+		{name: "multi-byte", input: "héllo wörld ✓"},
+		{name: "encoded replacement char is valid", input: "a�b"},
+		{name: "lone continuation byte", input: "ab\x80c", wantErr: "validation failed: invalid UTF-8 at byte 2"},
+		{name: "truncated sequence", input: "é\xe2\x82", wantErr: "validation failed: invalid UTF-8 at byte 2"},
+		{name: "latin-1 byte", input: "caf\xe9", wantErr: "validation failed: invalid UTF-8 at byte 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomorph.ValidateUTF8().From(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				var validationErr *gomorph.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, gomorph.CodeInvalidUTF8, validationErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, got)
+		})
+	}
+}
+
+func TestRepairUTF8(t *testing.T) {
+	tests := []struct {
+		name        string
+		replacement rune
+		input       string
+		expected    string
+	}{
+		{name: "valid untouched", replacement: '?', input: "héllo", expected: "héllo"},
+		{name: "single invalid byte", replacement: '?', input: "caf\xe9", expected: "caf?"},
+		{name: "run of invalid bytes", replacement: '�', input: "a\xff\xfeb", expected: "a�b"},
+		{name: "drop invalid bytes", replacement: -1, input: "a\x80b\xe2\x82", expected: "ab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomorph.RepairUTF8(tt.replacement).From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+
+			_, err = gomorph.ValidateUTF8().From(got)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTruncateString(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxBytes   int
+		onBoundary bool
+		input      string
+		expected   string
+	}{
+		{name: "short enough", maxBytes: 10, input: "hello", expected: "hello"},
+		{name: "exact length", maxBytes: 5, input: "hello", expected: "hello"},
+		{name: "ascii cut", maxBytes: 3, input: "hello", expected: "hel"},
+		{name: "zero", maxBytes: 0, input: "hello", expected: ""},
+		// "é" is two bytes (0xC3 0xA9) starting at byte 1.
+		{name: "splits rune without boundary", maxBytes: 2, input: "héllo", expected: "h\xc3"},
+		{name: "backs off to rune start", maxBytes: 2, onBoundary: true, input: "héllo", expected: "h"},
+		{name: "cut on boundary already", maxBytes: 3, onBoundary: true, input: "héllo", expected: "hé"},
+		{name: "four byte rune", maxBytes: 3, onBoundary: true, input: "😀x", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomorph.TruncateString(tt.maxBytes, tt.onBoundary).From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	assert.Panics(t, func() { gomorph.TruncateString(-1, false) })
+}
+
+func TestMaxBytes(t *testing.T) {
+	got, err := gomorph.MaxBytes(5).From("héllo")
+	require.Error(t, err, "é counts as two bytes")
+	assert.EqualError(t, err, "validation failed: value is 6 bytes long, at most 5 allowed")
+	var validationErr *gomorph.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, gomorph.CodeTooLong, validationErr.Code)
+
+	got, err = gomorph.MaxBytes(5).From("hello")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", got)
+
+	assert.PanicsWithValue(t, "MaxBytes: negative byte limit -1", func() { gomorph.MaxBytes(-1) })
+}
+
+func TestReplaceAllRegex(t *testing.T) {
+	slug := gomorph.MustReplaceAllRegex([]gomorph.RegexReplacement{
+		{Pattern: `[^\w\s-]`, Repl: ""},
+		{Pattern: `[\s-]+`, Repl: "-"},
+	})
+	got, err := slug.From("Hello,  World - 2")
+	require.NoError(t, err)
+	assert.Equal(t, "Hello-World-2", got)
+
+	swap, err := gomorph.ReplaceAllRegex([]gomorph.RegexReplacement{
+		{Pattern: `(\w+), (\w+)`, Repl: "$2 $1"},
+		{Pattern: `Ada`, Repl: "A."},
+	})
+	require.NoError(t, err)
+	got, err = swap.From("Lovelace, Ada")
+	require.NoError(t, err)
+	assert.Equal(t, "A. Lovelace", got, "later replacements see earlier output")
+
+	_, err = gomorph.ReplaceAllRegex([]gomorph.RegexReplacement{{Pattern: "ok"}, {Pattern: "("}})
+	assert.ErrorContains(t, err, "replacement 1: error parsing regexp")
+	assert.Panics(t, func() { gomorph.MustReplaceAllRegex([]gomorph.RegexReplacement{{Pattern: "["}}) })
+}
+
+func TestNormalizeDigits(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "+1 (555) 010-9999", expected: "15550109999"},
+		{input: "4111-1111", expected: "41111111"},
+		{input: "no digits", expected: ""},
+		{input: "١٢٣4", expected: "4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := gomorph.NormalizeDigits().From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNormalizeNewlines(t *testing.T) {
+	tests := []struct {
+		name     string
+		to       string
+		input    string
+		expected string
+	}{
+		{name: "CRLF", input: "a\r\nb\r\n", expected: "a\nb\n"},
+		{name: "lone CR", input: "a\rb", expected: "a\nb"},
+		{name: "mixed", input: "a\r\nb\rc\nd", expected: "a\nb\nc\nd"},
+		{name: "CR CR LF", input: "a\r\r\nb", expected: "a\n\nb"},
+		{name: "LF CR is two endings", input: "a\n\rb", expected: "a\n\nb"},
+		{name: "to CRLF", to: "\r\n", input: "a\nb\r\nc\rd", expected: "a\r\nb\r\nc\r\nd"},
+		{name: "no newlines", input: "plain", expected: "plain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomorph.NormalizeNewlines(tt.to).From(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
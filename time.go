@@ -0,0 +1,73 @@
+package gomorph
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseTimeInLocation parses strings with layout (string -> time.Time), interpreting timestamps
+// without an explicit zone or offset as local times in loc. Timestamps that carry an offset keep
+// it, as with time.ParseInLocation. A nil loc is treated as UTC.
+func ParseTimeInLocation(layout string, loc *time.Location) TypedMapper {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return namedFunc[string, time.Time]("ParseTimeInLocation", func(s string) (time.Time, error) {
+		t, err := time.ParseInLocation(layout, s, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parse time %q in %v: %w", s, loc, err)
+		}
+		return t, nil
+	})
+}
+
+// ReformatTime rewrites a date or time string from fromLayout to toLayout (string -> string) in
+// one step, e.g. "01/02/2006" to "2006-01-02". The value is parsed with time.Parse, so strings
+// without a zone are read as UTC. Parse failures name both layouts.
+func ReformatTime(fromLayout, toLayout string) TypedMapper {
+	return namedFunc[string, string]("ReformatTime", func(s string) (string, error) {
+		t, err := time.Parse(fromLayout, s)
+		if err != nil {
+			return "", fmt.Errorf("reformat time %q from %q to %q: %w", s, fromLayout, toLayout, err)
+		}
+		return t.Format(toLayout), nil
+	})
+}
+
+// ConvertTimeZone moves a time into loc (time.Time -> time.Time). The instant is unchanged; only
+// the location used to present it differs, so converting to time.UTC normalizes timestamps for
+// storage. A nil loc is treated as UTC.
+func ConvertTimeZone(loc *time.Location) TypedMapper {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return namedFunc[time.Time, time.Time]("ConvertTimeZone", func(t time.Time) (time.Time, error) {
+		return t.In(loc), nil
+	})
+}
+
+// DateInRange validates that a time lies within [min, max], bounds included
+// (time.Time -> time.Time). A zero min or max leaves that side of the range open. Times are
+// compared as instants, so time zones do not matter. The bounds are fixed when the validator is
+// created; rebuild it to express rules such as "in the past" against a moving clock. Failures
+// carry CodeDateRange and show both bounds.
+func DateInRange(min, max time.Time) Validator {
+	if !min.IsZero() && !max.IsZero() && min.After(max) {
+		panic(fmt.Sprintf("DateInRange: min %s is after max %s", min.Format(time.RFC3339), max.Format(time.RFC3339)))
+	}
+	bounds := fmt.Sprintf("[%s, %s]", formatRangeBound(min), formatRangeBound(max))
+	return namedFunc[time.Time, time.Time]("DateInRange", func(t time.Time) (time.Time, error) {
+		if (!min.IsZero() && t.Before(min)) || (!max.IsZero() && t.After(max)) {
+			return t, NewValidationError("", t, fmt.Sprintf("%s is outside %s", t.Format(time.RFC3339), bounds)).
+				WithCode(CodeDateRange)
+		}
+		return t, nil
+	})
+}
+
+func formatRangeBound(t time.Time) string {
+	if t.IsZero() {
+		return "open"
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,149 @@
+package gomorph_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone data for %s unavailable: %v", name, err)
+	}
+	return loc
+}
+
+func TestParseTimeInLocation(t *testing.T) {
+	berlin := loadLocation(t, "Europe/Berlin")
+	parse := gomorph.ParseTimeInLocation("2006-01-02 15:04", berlin)
+
+	got, err := parse.From("2024-07-01 12:00")
+	require.NoError(t, err)
+	assert.Equal(t, berlin, got.(time.Time).Location())
+	assert.True(t, time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC).Equal(got.(time.Time)))
+
+	_, err = parse.From("not a time")
+	assert.ErrorContains(t, err, `parse time "not a time" in Europe/Berlin`)
+
+	utc, err := gomorph.ParseTimeInLocation(time.RFC3339, nil).From("2024-07-01T12:00:00+02:00")
+	require.NoError(t, err)
+	assert.True(t, time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC).Equal(utc.(time.Time)), "explicit offsets win")
+}
+
+func TestReformatTime(t *testing.T) {
+	tests := []struct {
+		name       string
+		fromLayout string
+		toLayout   string
+		input      string
+		expected   string
+		wantErr    string
+	}{
+		{name: "US date to ISO", fromLayout: "01/02/2006", toLayout: "2006-01-02", input: "07/04/2024", expected: "2024-07-04"},
+		{name: "RFC3339 keeps offset", fromLayout: time.RFC3339, toLayout: "2006-01-02 15:04 -0700", input: "2024-07-01T12:00:00+02:00", expected: "2024-07-01 12:00 +0200"},
+		{name: "zone-less input is UTC", fromLayout: "2006-01-02", toLayout: time.RFC3339, input: "2024-07-01", expected: "2024-07-01T00:00:00Z"},
+		{
+			name:       "parse failure",
+			fromLayout: "01/02/2006",
+			toLayout:   "2006-01-02",
+			input:      "2024-07-04",
+			wantErr:    `reformat time "2024-07-04" from "01/02/2006" to "2006-01-02"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomorph.ReformatTime(tt.fromLayout, tt.toLayout).From(tt.input)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestConvertTimeZone(t *testing.T) {
+	newYork := loadLocation(t, "America/New_York")
+	toNewYork := gomorph.ConvertTimeZone(newYork)
+
+	tests := []struct {
+		name     string
+		input    time.Time
+		expected string
+	}{
+		// DST starts 2024-03-10 at 02:00 local (07:00 UTC): the clock jumps from EST to EDT.
+		{name: "before spring forward", input: time.Date(2024, 3, 10, 6, 59, 0, 0, time.UTC), expected: "2024-03-10 01:59 EST"},
+		{name: "after spring forward", input: time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC), expected: "2024-03-10 03:00 EDT"},
+		// DST ends 2024-11-03 at 02:00 local (06:00 UTC): 01:00-02:00 local happens twice.
+		{name: "first 01:30 in fall", input: time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC), expected: "2024-11-03 01:30 EDT"},
+		{name: "second 01:30 in fall", input: time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC), expected: "2024-11-03 01:30 EST"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toNewYork.From(tt.input)
+			require.NoError(t, err)
+			converted := got.(time.Time)
+			assert.Equal(t, tt.expected, converted.Format("2006-01-02 15:04 MST"))
+			assert.True(t, tt.input.Equal(converted), "the instant is unchanged")
+
+			back, err := gomorph.ConvertTimeZone(nil).From(converted)
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, back)
+		})
+	}
+}
+
+func TestDateInRange(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dec := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	jun := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		validator gomorph.Validator
+		input     time.Time
+		wantErr   string
+	}{
+		{name: "inside", validator: gomorph.DateInRange(jan, dec), input: jun},
+		{name: "min is inclusive", validator: gomorph.DateInRange(jan, dec), input: jan},
+		{name: "max is inclusive", validator: gomorph.DateInRange(jan, dec), input: dec},
+		{name: "same instant in another zone", validator: gomorph.DateInRange(jan, dec), input: jan.In(time.FixedZone("UTC+2", 2*3600))},
+		{
+			name:      "before min",
+			validator: gomorph.DateInRange(jan, dec),
+			input:     before,
+			wantErr:   "validation failed: 2023-12-31T00:00:00Z is outside [2024-01-01T00:00:00Z, 2024-12-31T00:00:00Z]",
+		},
+		{
+			name:      "after max with open min",
+			validator: gomorph.DateInRange(time.Time{}, jan),
+			input:     jun,
+			wantErr:   "validation failed: 2024-06-15T00:00:00Z is outside [open, 2024-01-01T00:00:00Z]",
+		},
+		{name: "open max", validator: gomorph.DateInRange(jan, time.Time{}), input: dec.AddDate(10, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.validator.From(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assertValidationCode(t, err, gomorph.CodeDateRange)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, got)
+		})
+	}
+
+	assert.Panics(t, func() { gomorph.DateInRange(dec, jan) })
+}
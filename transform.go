@@ -88,7 +88,96 @@ func (m *TransformMapper[K, TSource, TDest, TMeta]) From(source TSource) (TDest,
 	transform, ok := m.resolver.Resolve(key)
 	if !ok {
 		var zero TDest
-		return zero, fmt.Errorf("no transform for key: %v", key)
+		return zero, &UnknownKeyError{Key: key, Known: anyKeys(m.SupportedOperations())}
 	}
 	return transform(source, m.meta)
 }
+
+// UnknownKeyError is returned by TransformMapper when the resolver has no transform for the key
+// derived from the source. Known lists the supported keys when the resolver can enumerate them.
+type UnknownKeyError struct {
+	Key   any
+	Known []any
+}
+
+func (e *UnknownKeyError) Error() string {
+	return fmt.Sprintf("no transform for key: %v", e.Key)
+}
+
+func anyKeys[K comparable](keys []K) []any {
+	if keys == nil {
+		return nil
+	}
+	result := make([]any, len(keys))
+	for i, k := range keys {
+		result[i] = k
+	}
+	return result
+}
+
+// OneofResolver is a TransformResolver for protobuf-style oneof fields, where a discriminator on
+// the source selects which variant of the destination to produce. In generated protobuf code a
+// oneof is an interface (TDest) implemented by one wrapper type per variant; each case builds its
+// variant and wraps it in the matching concrete type, see OneofVariant.
+//
+// Example:
+//
+//	resolver := gomorph.NewOneofResolver[string, Payment, pb.isPayment_Method, any]().
+//	    Case("card", gomorph.OneofVariant(toCard, func(c *pb.Card) pb.isPayment_Method {
+//	        return &pb.Payment_Card{Card: c}
+//	    })).
+//	    Case("bank", gomorph.OneofVariant(toBank, func(b *pb.Bank) pb.isPayment_Method {
+//	        return &pb.Payment_Bank{Bank: b}
+//	    }))
+//	mapper := gomorph.NewTransformMapper(resolver, nil, func(p Payment) string { return p.Kind })
+//
+// Unrecognised discriminators make the TransformMapper return an *UnknownKeyError listing the
+// known variants.
+type OneofResolver[K comparable, TSource, TDest, TMeta any] struct {
+	keys  []K
+	cases map[K]TransformFunc[TSource, TDest, TMeta]
+}
+
+func NewOneofResolver[K comparable, TSource, TDest, TMeta any]() *OneofResolver[K, TSource, TDest, TMeta] {
+	return &OneofResolver[K, TSource, TDest, TMeta]{
+		cases: make(map[K]TransformFunc[TSource, TDest, TMeta]),
+	}
+}
+
+// Case registers the transform producing the variant for key, replacing any earlier case for the
+// same key.
+func (r *OneofResolver[K, TSource, TDest, TMeta]) Case(key K, transform TransformFunc[TSource, TDest, TMeta]) *OneofResolver[K, TSource, TDest, TMeta] {
+	if _, exists := r.cases[key]; !exists {
+		r.keys = append(r.keys, key)
+	}
+	r.cases[key] = transform
+	return r
+}
+
+func (r *OneofResolver[K, TSource, TDest, TMeta]) Resolve(key K) (TransformFunc[TSource, TDest, TMeta], bool) {
+	transform, ok := r.cases[key]
+	return transform, ok
+}
+
+// Keys returns the registered discriminators in registration order.
+func (r *OneofResolver[K, TSource, TDest, TMeta]) Keys() []K {
+	keys := make([]K, len(r.keys))
+	copy(keys, r.keys)
+	return keys
+}
+
+// OneofVariant adapts a transform producing a concrete variant into one producing the oneof
+// interface, by passing its result through wrap.
+func OneofVariant[TSource, TVariant, TDest, TMeta any](
+	transform TransformFunc[TSource, TVariant, TMeta],
+	wrap func(TVariant) TDest,
+) TransformFunc[TSource, TDest, TMeta] {
+	return func(source TSource, meta TMeta) (TDest, error) {
+		variant, err := transform(source, meta)
+		if err != nil {
+			var zero TDest
+			return zero, err
+		}
+		return wrap(variant), nil
+	}
+}
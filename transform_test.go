@@ -1,6 +1,7 @@
 package gomorph_test
 
 import (
+	"errors"
 	"github.com/dklassen/gomorph"
 	"reflect"
 	"sort"
@@ -105,3 +106,59 @@ func TestGenericMapMapper(t *testing.T) {
 		})
 	}
 }
+
+// Protobuf-style oneof: an interface with one wrapper type per variant.
+type isPayment_Method interface{ isPayment_Method() }
+
+type Card struct{ Number string }
+type Bank struct{ IBAN string }
+
+type Payment_Card struct{ Card *Card }
+type Payment_Bank struct{ Bank *Bank }
+
+func (*Payment_Card) isPayment_Method() {}
+func (*Payment_Bank) isPayment_Method() {}
+
+type DomainPayment struct {
+	Kind    string
+	Account string
+}
+
+func TestOneofResolver(t *testing.T) {
+	resolver := gomorph.NewOneofResolver[string, DomainPayment, isPayment_Method, any]().
+		Case("card", gomorph.OneofVariant(
+			func(p DomainPayment, _ any) (*Card, error) { return &Card{Number: p.Account}, nil },
+			func(c *Card) isPayment_Method { return &Payment_Card{Card: c} },
+		)).
+		Case("bank", gomorph.OneofVariant(
+			func(p DomainPayment, _ any) (*Bank, error) { return &Bank{IBAN: p.Account}, nil },
+			func(b *Bank) isPayment_Method { return &Payment_Bank{Bank: b} },
+		))
+
+	mapper := gomorph.NewTransformMapper(resolver, nil, func(p DomainPayment) string { return p.Kind })
+
+	if got := mapper.SupportedOperations(); !reflect.DeepEqual(got, []string{"card", "bank"}) {
+		t.Errorf("expected keys in registration order, got %v", got)
+	}
+
+	method, err := mapper.From(DomainPayment{Kind: "bank", Account: "DE89"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := &Payment_Bank{Bank: &Bank{IBAN: "DE89"}}
+	if !reflect.DeepEqual(method, want) {
+		t.Errorf("got %+v, want %+v", method, want)
+	}
+
+	_, err = mapper.From(DomainPayment{Kind: "crypto"})
+	var unknown *gomorph.UnknownKeyError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("expected UnknownKeyError, got %v", err)
+	}
+	if unknown.Key != "crypto" || !reflect.DeepEqual(unknown.Known, []any{"card", "bank"}) {
+		t.Errorf("unexpected error contents: %+v", unknown)
+	}
+	if err.Error() != "no transform for key: crypto" {
+		t.Errorf("unexpected error message: %q", err.Error())
+	}
+}
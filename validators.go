@@ -0,0 +1,204 @@
+package gomorph
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// DecimalConstraint validates that a decimal string fits a NUMERIC(precision, scale) column
+// (string -> string): at most scale digits after the decimal point and at most
+// precision-scale digits before it. Leading zeros of the integer part and trailing zeros of the
+// fraction do not change the value and are not counted, so "007.50" fits NUMERIC(3,1). The value
+// itself is returned unchanged. Malformed numbers fail with CodeInvalidDecimal and values that
+// do not fit with CodeDecimalRange.
+func DecimalConstraint(precision, scale int) Validator {
+	return decimalConstraintOf("DecimalConstraint", precision, scale, func(s string) string { return s })
+}
+
+// DecimalConstraintOf applies DecimalConstraint to a decimal type of your choice (T -> T), using
+// accessor to render the value as a plain decimal string, e.g. decimal.Decimal.String.
+func DecimalConstraintOf[T any](precision, scale int, accessor func(T) string) Validator {
+	return decimalConstraintOf("DecimalConstraintOf", precision, scale, accessor)
+}
+
+// decimalConstraintOf implements DecimalConstraint and DecimalConstraintOf, naming the step and
+// any configuration panic after the calling constructor.
+func decimalConstraintOf[T any](name string, precision, scale int, accessor func(T) string) Validator {
+	if precision <= 0 || scale < 0 || scale > precision {
+		panic(fmt.Sprintf("%s: invalid NUMERIC(%d,%d)", name, precision, scale))
+	}
+
+	return namedFunc[T, T](name, func(value T) (T, error) {
+		s := accessor(value)
+		intDigits, fracDigits, ok := decimalDigits(s)
+		if !ok {
+			return value, NewValidationError("", value, fmt.Sprintf("%q is not a decimal number", s)).
+				WithCode(CodeInvalidDecimal)
+		}
+		if fracDigits > scale {
+			return value, NewValidationError("", value,
+				fmt.Sprintf("%q has %d decimal places, NUMERIC(%d,%d) allows %d", s, fracDigits, precision, scale, scale)).
+				WithCode(CodeDecimalRange)
+		}
+		if intDigits > precision-scale {
+			return value, NewValidationError("", value,
+				fmt.Sprintf("%q has %d integer digits, NUMERIC(%d,%d) allows %d", s, intDigits, precision, scale, precision-scale)).
+				WithCode(CodeDecimalRange)
+		}
+		return value, nil
+	})
+}
+
+// decimalDigits counts the significant integer and fraction digits of a decimal string of the
+// form [+-]digits[.digits].
+func decimalDigits(s string) (intDigits, fracDigits int, ok bool) {
+	if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasPoint := strings.Cut(s, ".")
+	switch {
+	case intPart == "" && fracPart == "":
+		return 0, 0, false
+	case intPart != "" && !isNumericIdentifier(intPart):
+		return 0, 0, false
+	case hasPoint && !isNumericIdentifier(fracPart):
+		return 0, 0, false
+	}
+	return len(strings.TrimLeft(intPart, "0")), len(strings.TrimRight(fracPart, "0")), true
+}
+
+// DigitsOnly validates that a string consists of ASCII digits only (string -> string), rejecting
+// empty strings, signs, separators and whitespace with CodeNotNumeric. Use it with PreValidateWith
+// to reject malformed input before a string-to-int converter sees it.
+func DigitsOnly() Validator {
+	return namedFunc[string, string]("DigitsOnly", func(value string) (string, error) {
+		if !isNumericIdentifier(value) {
+			return value, NewValidationError("", value, fmt.Sprintf("%q must contain digits only", value)).
+				WithCode(CodeNotNumeric)
+		}
+		return value, nil
+	})
+}
+
+// Numeric is like DigitsOnly but also accepts a leading sign and a decimal point, i.e. strings
+// of the form [+-]digits[.digits] such as "-12", "+0.5" or ".25".
+func Numeric() Validator {
+	return namedFunc[string, string]("Numeric", func(value string) (string, error) {
+		if _, _, ok := decimalDigits(value); !ok {
+			return value, NewValidationError("", value, fmt.Sprintf("%q is not a number", value)).
+				WithCode(CodeNotNumeric)
+		}
+		return value, nil
+	})
+}
+
+// LuhnValidate validates a card-style number against the Luhn checksum (string -> string). Spaces
+// and dashes are ignored, so "4111 1111 1111 1111" passes unchanged; any other non-digit fails with
+// CodeNotNumeric, and a wrong check digit with CodeBadChecksum. At least two digits are required.
+// Combine it with NormalizeDigits to store the bare digits.
+func LuhnValidate() Validator {
+	return namedFunc[string, string]("LuhnValidate", func(value string) (string, error) {
+		digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+		if len(digits) < 2 || !isNumericIdentifier(digits) {
+			return value, NewValidationError("", value, fmt.Sprintf("%q is not a card number", value)).
+				WithCode(CodeNotNumeric)
+		}
+		if !luhnValid(digits) {
+			return value, NewValidationError("", value, fmt.Sprintf("%q fails the Luhn checksum", value)).
+				WithCode(CodeBadChecksum)
+		}
+		return value, nil
+	})
+}
+
+// luhnValid reports whether an all-digit string ends in a correct Luhn check digit.
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// inSetSampleSize caps the number of allowed values InSet lists in its error message.
+const inSetSampleSize = 5
+
+// InSet validates that a string is one of the keys currently reported by lister
+// (string -> string). The keys are read on every call, so a lister backed by a reloadable source
+// such as a database changes the allowed set without rebuilding the mapper. Failures carry
+// CodeNotAllowed and list up to five of the allowed values in sorted order.
+func InSet(lister KeyLister[string]) Validator {
+	return namedFunc[string, string]("InSet", func(value string) (string, error) {
+		keys := lister.Keys()
+		if slices.Contains(keys, value) {
+			return value, nil
+		}
+
+		sample := slices.Sorted(slices.Values(keys))
+		more := ""
+		if len(sample) > inSetSampleSize {
+			more = fmt.Sprintf(", ... (%d more)", len(sample)-inSetSampleSize)
+			sample = sample[:inSetSampleSize]
+		}
+		return value, NewValidationError("", value,
+			fmt.Sprintf("%q is not allowed; expected one of %s%s", value, strings.Join(sample, ", "), more)).
+			WithCode(CodeNotAllowed)
+	})
+}
+
+// SkipZeroValidator runs inner only for non-zero values and passes zero values through
+// unvalidated, so optional fields that were left unset do not fail checks meant for real values.
+// A value is zero when it is nil or reflect.Value.IsZero reports it: "", 0, false, a nil pointer,
+// slice or map, or a struct whose fields are all zero. Empty but non-nil slices and maps are not
+// zero. Use SkipZeroValidatorFunc to supply a different definition.
+func SkipZeroValidator(inner Validator) Validator {
+	return skipZeroValidator{name: "SkipZeroValidator", inner: inner, isZero: isZeroValue}
+}
+
+// SkipZeroValidatorFunc is like SkipZeroValidator but uses isZero to decide which values skip
+// validation.
+func SkipZeroValidatorFunc(inner Validator, isZero func(any) bool) Validator {
+	return skipZeroValidator{name: "SkipZeroValidatorFunc", inner: inner, isZero: isZero}
+}
+
+type skipZeroValidator struct {
+	name   string
+	inner  Validator
+	isZero func(any) bool
+}
+
+// Name reports the constructor along with the step it wraps, e.g. "SkipZeroValidator(DigitsOnly)".
+func (v skipZeroValidator) Name() string {
+	return fmt.Sprintf("%s(%s)", v.name, stepName(v.inner))
+}
+
+func (v skipZeroValidator) From(value any) (any, error) {
+	if v.isZero(value) {
+		return value, nil
+	}
+	return v.inner.From(value)
+}
+
+func (v skipZeroValidator) SourceType() reflect.Type {
+	return v.inner.SourceType()
+}
+
+func (v skipZeroValidator) TargetType() reflect.Type {
+	return v.inner.TargetType()
+}
+
+func isZeroValue(value any) bool {
+	return value == nil || reflect.ValueOf(value).IsZero()
+}
@@ -0,0 +1,223 @@
+package gomorph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dklassen/gomorph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assertValidationCode checks that err is a *ValidationError carrying code.
+func assertValidationCode(t *testing.T, err error, code string) {
+	t.Helper()
+	var validationErr *gomorph.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, code, validationErr.Code)
+}
+
+func TestDecimalConstraint(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantCode string
+		wantErr  string
+	}{
+		{name: "fits", input: "123.45"},
+		{name: "negative", input: "-999.99"},
+		{name: "explicit plus", input: "+1"},
+		{name: "leading and trailing zeros ignored", input: "000123.4500"},
+		{name: "fraction only", input: ".5"},
+		{name: "too many decimal places", input: "1.234", wantCode: gomorph.CodeDecimalRange,
+			wantErr: `"1.234" has 3 decimal places, NUMERIC(5,2) allows 2`},
+		{name: "too many integer digits", input: "1234.5", wantCode: gomorph.CodeDecimalRange,
+			wantErr: `"1234.5" has 4 integer digits, NUMERIC(5,2) allows 3`},
+		{name: "not a number", input: "12a", wantCode: gomorph.CodeInvalidDecimal},
+		{name: "double sign", input: "--1", wantCode: gomorph.CodeInvalidDecimal},
+		{name: "dangling point", input: "1.", wantCode: gomorph.CodeInvalidDecimal},
+		{name: "empty", input: "", wantCode: gomorph.CodeInvalidDecimal},
+	}
+
+	validator := gomorph.DecimalConstraint(5, 2)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validator.From(tt.input)
+			if tt.wantCode != "" {
+				assertValidationCode(t, err, tt.wantCode)
+				if tt.wantErr != "" {
+					assert.ErrorContains(t, err, tt.wantErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, got)
+		})
+	}
+}
+
+type Money struct {
+	Units string
+}
+
+func TestDecimalConstraintOf(t *testing.T) {
+	validator := gomorph.DecimalConstraintOf(4, 0, func(m Money) string { return m.Units })
+
+	got, err := validator.From(Money{Units: "9999"})
+	require.NoError(t, err)
+	assert.Equal(t, Money{Units: "9999"}, got)
+
+	_, err = validator.From(Money{Units: "10000"})
+	assertValidationCode(t, err, gomorph.CodeDecimalRange)
+
+	assert.Panics(t, func() { gomorph.DecimalConstraint(2, 3) })
+}
+
+func TestDigitsOnlyAndNumeric(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator gomorph.Validator
+		input     string
+		wantErr   string
+	}{
+		{name: "digits", validator: gomorph.DigitsOnly(), input: "0123"},
+		{name: "digits rejects sign", validator: gomorph.DigitsOnly(), input: "-1", wantErr: `validation failed: "-1" must contain digits only`},
+		{name: "digits rejects empty", validator: gomorph.DigitsOnly(), input: "", wantErr: `validation failed: "" must contain digits only`},
+		{name: "digits rejects spaces", validator: gomorph.DigitsOnly(), input: " 1", wantErr: `validation failed: " 1" must contain digits only`},
+		{name: "numeric integer", validator: gomorph.Numeric(), input: "42"},
+		{name: "numeric signed decimal", validator: gomorph.Numeric(), input: "-12.50"},
+		{name: "numeric leading point", validator: gomorph.Numeric(), input: "+.25"},
+		{name: "numeric rejects letters", validator: gomorph.Numeric(), input: "1e3", wantErr: `validation failed: "1e3" is not a number`},
+		{name: "numeric rejects lone sign", validator: gomorph.Numeric(), input: "-", wantErr: `validation failed: "-" is not a number`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.validator.From(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assertValidationCode(t, err, gomorph.CodeNotNumeric)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, result)
+		})
+	}
+}
+
+// reloadableKeys is a KeyLister whose keys can be replaced at runtime.
+type reloadableKeys struct {
+	keys []string
+}
+
+func (r *reloadableKeys) Keys() []string { return r.keys }
+
+func TestInSet(t *testing.T) {
+	allowed := &reloadableKeys{keys: []string{"Wizard", "Rogue"}}
+	validator := gomorph.InSet(allowed)
+
+	got, err := validator.From("Rogue")
+	require.NoError(t, err)
+	assert.Equal(t, "Rogue", got)
+
+	_, err = validator.From("Bard")
+	assert.EqualError(t, err, `validation failed: "Bard" is not allowed; expected one of Rogue, Wizard`)
+	assertValidationCode(t, err, gomorph.CodeNotAllowed)
+
+	allowed.keys = append(allowed.keys, "Bard")
+	_, err = validator.From("Bard")
+	assert.NoError(t, err, "the allowed set is read on every call")
+
+	allowed.keys = []string{"g", "f", "e", "d", "c", "b", "a"}
+	_, err = validator.From("z")
+	assert.EqualError(t, err, `validation failed: "z" is not allowed; expected one of a, b, c, d, e, ... (2 more)`)
+}
+
+func TestInSet_WithResolver(t *testing.T) {
+	resolver := gomorph.NewMapResolver(map[string]gomorph.TransformFunc[string, string, struct{}]{
+		"usd": nil,
+		"eur": nil,
+	})
+	_, err := gomorph.InSet(resolver).From("gbp")
+	assert.EqualError(t, err, `validation failed: "gbp" is not allowed; expected one of eur, usd`)
+}
+
+func TestSkipZeroValidator(t *testing.T) {
+	optionalLevel := gomorph.SkipZeroValidator(failingValidator{})
+
+	got, err := optionalLevel.From(0)
+	require.NoError(t, err, "zero values skip validation")
+	assert.Equal(t, 0, got)
+
+	_, err = optionalLevel.From(5)
+	assert.EqualError(t, err, "validation failed")
+
+	optionalCode := gomorph.SkipZeroValidator(gomorph.DigitsOnly())
+	_, err = optionalCode.From("")
+	assert.NoError(t, err)
+	_, err = optionalCode.From("12a")
+	assertValidationCode(t, err, gomorph.CodeNotNumeric)
+
+	assert.Equal(t, reflect.TypeOf(0), optionalLevel.SourceType())
+	assert.Equal(t, reflect.TypeOf(0), optionalLevel.TargetType())
+
+	_, err = gomorph.NewChainedMapper[string, string](optionalCode).Map("12a")
+	assert.ErrorContains(t, err, "mapper chain failed at step 1 (SkipZeroValidator(DigitsOnly))")
+}
+
+func TestSkipZeroValidatorFunc(t *testing.T) {
+	// Treat negative values as "unset" instead of zero.
+	unsetIfNegative := gomorph.SkipZeroValidatorFunc(failingValidator{}, func(v any) bool {
+		return v.(int) < 0
+	})
+
+	_, err := unsetIfNegative.From(-1)
+	assert.NoError(t, err)
+	_, err = unsetIfNegative.From(0)
+	assert.EqualError(t, err, "validation failed")
+
+	_, err = gomorph.NewChainedMapper[int, int](unsetIfNegative).Map(0)
+	assert.ErrorContains(t, err, "mapper chain failed at step 1 (SkipZeroValidatorFunc(gomorph_test.failingValidator))")
+}
+
+func TestLuhnValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantErr  string
+		wantCode string
+	}{
+		{name: "visa test number", input: "4111111111111111"},
+		{name: "spaces and dashes", input: "4242 4242-4242 4242"},
+		{name: "amex test number", input: "378282246310005"},
+		{name: "short valid", input: "18"},
+		{name: "wrong check digit", input: "4111111111111112", wantErr: `validation failed: "4111111111111112" fails the Luhn checksum`, wantCode: gomorph.CodeBadChecksum},
+		{name: "transposed digits", input: "4242424242424224", wantErr: `validation failed: "4242424242424224" fails the Luhn checksum`, wantCode: gomorph.CodeBadChecksum},
+		{name: "letters", input: "4111-abcd", wantErr: `validation failed: "4111-abcd" is not a card number`, wantCode: gomorph.CodeNotNumeric},
+		{name: "single digit", input: "0", wantErr: `validation failed: "0" is not a card number`, wantCode: gomorph.CodeNotNumeric},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := gomorph.LuhnValidate().From(tt.input)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assertValidationCode(t, err, tt.wantCode)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, result)
+		})
+	}
+}
+
+func TestLuhnValidate_AfterNormalizeDigits(t *testing.T) {
+	chain := gomorph.NewChainedMapper[string, string](gomorph.NormalizeDigits(), gomorph.LuhnValidate())
+
+	got, err := chain.Map("4111.1111.1111.1111")
+	require.NoError(t, err)
+	assert.Equal(t, "4111111111111111", got)
+
+	_, err = chain.Map("card: 4111 1111 1111 1112")
+	assertValidationCode(t, err, gomorph.CodeBadChecksum)
+}